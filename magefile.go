@@ -64,6 +64,29 @@ func Test() error {
 	return sh.RunV("go", "test", "-v", "-race", "./...")
 }
 
+// TestGetg runs the unit tests again with the signals_getg build tag, which
+// swaps in the assembly goroutine lookup on amd64 and arm64.
+// Usage: mage testgetg
+func TestGetg() error {
+	fmt.Println("Running tests with signals_getg...")
+	return sh.RunV("go", "test", "-race", "-tags", "signals_getg", "./...")
+}
+
+// Bench runs the effect re-run benchmarks with the portable goroutine lookup
+// and with the signals_getg one, so a regression in either shows up.
+// Usage: mage bench
+func Bench() error {
+	fmt.Println("Benchmarking...")
+	for _, tags := range []string{"", "signals_getg"} {
+		err := sh.RunV("go", "test", "-tags", tags, "-run", "^$",
+			"-bench", "EffectRerun", "-benchtime", "2000x", "./pkg/signals")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Clean removes build artifacts.
 // Usage: mage clean
 func Clean() {
@@ -97,7 +120,10 @@ func All() error {
 
 // CI is a stricter pipeline entrypoint; logs failure early.
 func CI() {
-	if err := All(); err != nil {
-		log.Fatalf("CI failed: %v", err)
+	steps := []func() error{All, TestGetg, Bench}
+	for _, step := range steps {
+		if err := step(); err != nil {
+			log.Fatalf("CI failed: %v", err)
+		}
 	}
 }
@@ -0,0 +1,147 @@
+package signals
+
+import "sync"
+
+var _ Signal[int] = (*ActorSignal[int])(nil)
+
+// ActorSignal is a signal whose writes are applied by a single goroutine of
+// its own, in the order they were made. Set and Update only enqueue the
+// write and return at once, so writers on any goroutine never race each
+// other; the write takes effect, and subscribers are notified on the actor
+// goroutine, some time later. Get returns the latest applied value. Use Wait
+// to know a write has landed. It implements Signal[T].
+type ActorSignal[T any] struct {
+	sig *signal[T]
+
+	mu      sync.Mutex
+	queue   []func()
+	stopped bool
+	wake    chan struct{}
+	done    chan struct{}
+}
+
+// NewActorSignal creates an actor signal holding initial. Its goroutine
+// exits when s is disposed, dropping writes that haven't been applied.
+func NewActorSignal[T any](s *Scope, initial T) *ActorSignal[T] {
+	a := &ActorSignal[T]{
+		sig:  New(s, initial).(*signal[T]),
+		wake: make(chan struct{}, 1),
+		done: make(chan struct{}),
+	}
+	go a.run()
+	onScopeCleanup(s, a.stop)
+	return a
+}
+
+func (a *ActorSignal[T]) run() {
+	for {
+		select {
+		case <-a.done:
+			return
+		case <-a.wake:
+		}
+		for {
+			a.mu.Lock()
+			if a.stopped || len(a.queue) == 0 {
+				a.mu.Unlock()
+				break
+			}
+			fn := a.queue[0]
+			a.queue = a.queue[1:]
+			a.mu.Unlock()
+			fn()
+		}
+	}
+}
+
+// enqueue hands fn to the actor goroutine, reporting whether it was
+// accepted.
+func (a *ActorSignal[T]) enqueue(fn func()) bool {
+	a.mu.Lock()
+	if a.stopped {
+		a.mu.Unlock()
+		return false
+	}
+	a.queue = append(a.queue, fn)
+	a.mu.Unlock()
+	select {
+	case a.wake <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+func (a *ActorSignal[T]) stop() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if !a.stopped {
+		a.stopped = true
+		a.queue = nil
+		close(a.done)
+	}
+}
+
+// Get returns the latest applied value, subscribing the active computation.
+func (a *ActorSignal[T]) Get() T {
+	return a.sig.Get()
+}
+
+func (a *ActorSignal[T]) Peek() T {
+	return a.sig.Peek()
+}
+
+// Set enqueues a write of v.
+func (a *ActorSignal[T]) Set(v T) {
+	a.enqueue(func() {
+		a.sig.Set(v)
+	})
+}
+
+// Update enqueues fn, which the actor applies to a copy of the value current
+// at that point before storing it. Updates therefore compose: each sees the
+// result of every write enqueued before it.
+func (a *ActorSignal[T]) Update(fn func(*T)) {
+	a.enqueue(func() {
+		a.sig.mu.RLock()
+		v := a.sig.value
+		a.sig.mu.RUnlock()
+		fn(&v)
+		a.sig.Set(v)
+	})
+}
+
+// UpdateBatch is like Update, but the actor applies fn as Signal.UpdateBatch
+// does, in one batch with any writes fn makes to other signals.
+func (a *ActorSignal[T]) UpdateBatch(fn func(*T)) {
+	a.enqueue(func() {
+		a.sig.UpdateBatch(fn)
+	})
+}
+
+// Snapshot captures the latest applied value and returns a function that
+// enqueues a write back to it.
+func (a *ActorSignal[T]) Snapshot() (restore func()) {
+	a.sig.mu.RLock()
+	saved := a.sig.value
+	a.sig.mu.RUnlock()
+	return func() {
+		a.Set(saved)
+	}
+}
+
+// Wait blocks until every write enqueued before the call has been applied
+// and its subscribers notified, or the scope is disposed.
+func (a *ActorSignal[T]) Wait() {
+	applied := make(chan struct{})
+	if !a.enqueue(func() { close(applied) }) {
+		return
+	}
+	select {
+	case <-applied:
+	case <-a.done:
+	}
+}
+
+func (a *ActorSignal[T]) subscriberSnapshot() []computation {
+	return a.sig.subscriberSnapshot()
+}
@@ -0,0 +1,64 @@
+package signals
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestActorSignal_SerializesConcurrentWrites(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	const writers, perWriter = 8, 500
+	total := NewActorSignal(s, 0)
+	var (
+		mu   sync.Mutex
+		seen []int
+	)
+	Effect(s, func() {
+		v := total.Get()
+		mu.Lock()
+		seen = append(seen, v)
+		mu.Unlock()
+	})
+
+	var wg sync.WaitGroup
+	for range writers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range perWriter {
+				total.Update(func(v *int) { *v++ })
+			}
+		}()
+	}
+	wg.Wait()
+	total.Wait()
+
+	if got := total.Get(); got != writers*perWriter {
+		t.Errorf("Expected %d, got %d", writers*perWriter, got)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	for i, v := range seen {
+		if v != i {
+			t.Fatalf("Expected subscribers to observe every value in order, got %d at position %d", v, i)
+		}
+	}
+}
+
+func TestActorSignal_StopsWithScope(t *testing.T) {
+	eng := Start()
+	s := eng.Scope()
+	a := NewActorSignal(s, "a")
+	a.Set("b")
+	a.Wait()
+
+	eng.Close()
+	a.Set("c")
+	a.Wait() // Must not block once disposed.
+	if got := a.Get(); got != "b" {
+		t.Errorf("Expected writes after disposal to be dropped, got %q", got)
+	}
+}
@@ -0,0 +1,61 @@
+package signals
+
+import "errors"
+
+// Number is the set of types the arithmetic combinators work on.
+//
+// The arithmetic combinators return values that are recomputed eagerly when
+// an input changes, and notify their subscribers only when the result
+// differs from the previous one: Abs notifies nothing when its input flips
+// from -3 to 3. Integer results overflow silently, as in plain Go, and a NaN
+// input yields NaN.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~float32 | ~float64
+}
+
+// Add returns a value holding a + b.
+func Add[T Number](s *Scope, a, b Readonly[T]) Readonly[T] {
+	return derived(s, func() T { return a.Get() + b.Get() })
+}
+
+// Sub returns a value holding a - b.
+func Sub[T Number](s *Scope, a, b Readonly[T]) Readonly[T] {
+	return derived(s, func() T { return a.Get() - b.Get() })
+}
+
+// Mul returns a value holding a * b.
+func Mul[T Number](s *Scope, a, b Readonly[T]) Readonly[T] {
+	return derived(s, func() T { return a.Get() * b.Get() })
+}
+
+// Negate returns a value holding -src.
+func Negate[T Number](s *Scope, src Readonly[T]) Readonly[T] {
+	return derived(s, func() T { return -src.Get() })
+}
+
+// Abs returns a value holding the absolute value of src. As with plain
+// negation, the absolute value of the most negative integer is itself.
+func Abs[T Number](s *Scope, src Readonly[T]) Readonly[T] {
+	return derived(s, func() T {
+		v := src.Get()
+		if v < 0 {
+			return -v
+		}
+		return v
+	})
+}
+
+// ErrInvalidClampRange is the panic value raised when Clamp is given a lower
+// bound above its upper bound.
+var ErrInvalidClampRange = errors.New("signals: clamp lower bound exceeds upper bound")
+
+// Clamp returns a value holding src limited to the range [lo, hi]. It
+// panics with ErrInvalidClampRange if lo > hi.
+func Clamp[T Number](s *Scope, src Readonly[T], lo, hi T) Readonly[T] {
+	if lo > hi {
+		panic(ErrInvalidClampRange)
+	}
+	return derived(s, func() T {
+		return min(max(src.Get(), lo), hi)
+	})
+}
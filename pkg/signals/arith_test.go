@@ -0,0 +1,82 @@
+package signals
+
+import "testing"
+
+func TestArith_RecomputesOnInputChange(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	a := New(s, 6)
+	b := New(s, 4)
+	sum := Add(s, a, b)
+	diff := Sub(s, a, b)
+	prod := Mul(s, a, b)
+	neg := Negate[int](s, a)
+
+	check := func(want [4]int) {
+		t.Helper()
+		if got := [4]int{sum.Get(), diff.Get(), prod.Get(), neg.Get()}; got != want {
+			t.Errorf("Expected sum, diff, product, negation %v, got %v", want, got)
+		}
+	}
+	check([4]int{10, 2, 24, -6})
+	a.Set(1)
+	check([4]int{5, -3, 4, -1})
+	b.Set(-2)
+	check([4]int{-1, 3, -2, -1})
+}
+
+func TestAbs_SkipsUnchangedResult(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := New(s, -3.0)
+	abs := Abs[float64](s, src)
+	runs := 0
+	Effect(s, func() {
+		_ = abs.Get()
+		runs++
+	})
+
+	src.Set(3)
+	if runs != 1 || abs.Get() != 3 {
+		t.Errorf("Expected a sign flip not to notify, ran %d times with %v", runs, abs.Get())
+	}
+	src.Set(-4.5)
+	if runs != 2 || abs.Get() != 4.5 {
+		t.Errorf("Expected a new magnitude to notify, ran %d times with %v", runs, abs.Get())
+	}
+}
+
+func TestClamp_LimitsAndSkipsUnchangedResult(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := New(s, 5)
+	c := Clamp[int](s, src, 0, 10)
+	runs := 0
+	Effect(s, func() {
+		_ = c.Get()
+		runs++
+	})
+
+	src.Set(15)
+	if c.Get() != 10 || runs != 2 {
+		t.Errorf("Expected 10 after one notification, got %d after %d runs", c.Get(), runs)
+	}
+	src.Set(20)
+	if runs != 2 {
+		t.Errorf("Expected no notification while pinned at the bound, ran %d times", runs)
+	}
+	src.Set(-1)
+	if c.Get() != 0 {
+		t.Errorf("Expected the lower bound, got %d", c.Get())
+	}
+
+	if got := capturePanic(func() { Clamp[int](s, src, 2, 1) }); got != ErrInvalidClampRange {
+		t.Errorf("Expected ErrInvalidClampRange, got %v", got)
+	}
+}
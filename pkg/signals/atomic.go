@@ -0,0 +1,223 @@
+package signals
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	_ Signal[int64] = (*AtomicInt64)(nil)
+	_ Signal[bool]  = (*AtomicBool)(nil)
+)
+
+// AtomicInt64 is a Signal[int64] whose value is read and written with
+// sync/atomic operations instead of a mutex, for very hot counters and
+// gauges. Only dependency tracking takes a lock, and only when a read comes
+// from a computation. Writes of an equal value don't notify.
+type AtomicInt64 struct {
+	scope *Scope
+	value atomic.Int64
+	subs  subscriberSet
+}
+
+// NewAtomicInt64 creates an atomic-backed int64 signal.
+func NewAtomicInt64(s *Scope, initial int64) *AtomicInt64 {
+	a := &AtomicInt64{scope: s}
+	a.value.Store(initial)
+	watchLeaks(s.engine, a)
+	return a
+}
+
+func (a *AtomicInt64) Get() int64 {
+	a.subs.track(a, a.scope.engine)
+	return a.value.Load()
+}
+
+func (a *AtomicInt64) Peek() int64 {
+	return a.value.Load()
+}
+
+// Set stores v, after passing it through the engine's write middleware
+// like any signal's Set, with the name "".
+func (a *AtomicInt64) Set(v int64) {
+	if len(a.scope.engine.writeMiddleware) > 0 {
+		throughMiddleware(a.scope.engine, "", v, a.set)
+		return
+	}
+	a.set(v)
+}
+
+func (a *AtomicInt64) set(v int64) {
+	defer a.scope.engine.beginWrite()()
+	if a.value.Swap(v) != v {
+		a.subs.notify(a.scope.engine)
+	}
+}
+
+// Update applies fn atomically, retrying if another writer got there first.
+func (a *AtomicInt64) Update(fn func(*int64)) {
+	defer a.scope.engine.beginWrite()()
+	for {
+		old := a.value.Load()
+		next := old
+		fn(&next)
+		if a.value.CompareAndSwap(old, next) {
+			if old != next {
+				a.subs.notify(a.scope.engine)
+			}
+			return
+		}
+	}
+}
+
+// UpdateBatch is Update run inside a batch, so writes fn makes to other
+// signals are flushed together with this one.
+func (a *AtomicInt64) UpdateBatch(fn func(*int64)) {
+	a.scope.Batch(func() {
+		a.Update(fn)
+	})
+}
+
+func (a *AtomicInt64) Snapshot() (restore func()) {
+	saved := a.value.Load()
+	return func() {
+		a.scope.Batch(func() {
+			a.Set(saved)
+		})
+	}
+}
+
+func (a *AtomicInt64) unsubscribe(c computation) {
+	a.subs.remove(c)
+}
+
+// AtomicBool is a Signal[bool] whose value is read and written with
+// sync/atomic operations. See AtomicInt64.
+type AtomicBool struct {
+	scope *Scope
+	value atomic.Bool
+	subs  subscriberSet
+}
+
+// NewAtomicBool creates an atomic-backed bool signal.
+func NewAtomicBool(s *Scope, initial bool) *AtomicBool {
+	a := &AtomicBool{scope: s}
+	a.value.Store(initial)
+	watchLeaks(s.engine, a)
+	return a
+}
+
+func (a *AtomicBool) Get() bool {
+	a.subs.track(a, a.scope.engine)
+	return a.value.Load()
+}
+
+func (a *AtomicBool) Peek() bool {
+	return a.value.Load()
+}
+
+// Set stores v, after passing it through the engine's write middleware
+// like any signal's Set, with the name "".
+func (a *AtomicBool) Set(v bool) {
+	if len(a.scope.engine.writeMiddleware) > 0 {
+		throughMiddleware(a.scope.engine, "", v, a.set)
+		return
+	}
+	a.set(v)
+}
+
+func (a *AtomicBool) set(v bool) {
+	defer a.scope.engine.beginWrite()()
+	if a.value.Swap(v) != v {
+		a.subs.notify(a.scope.engine)
+	}
+}
+
+// Update applies fn atomically, retrying if another writer got there first.
+func (a *AtomicBool) Update(fn func(*bool)) {
+	defer a.scope.engine.beginWrite()()
+	for {
+		old := a.value.Load()
+		next := old
+		fn(&next)
+		if a.value.CompareAndSwap(old, next) {
+			if old != next {
+				a.subs.notify(a.scope.engine)
+			}
+			return
+		}
+	}
+}
+
+// UpdateBatch is Update run inside a batch, so writes fn makes to other
+// signals are flushed together with this one.
+func (a *AtomicBool) UpdateBatch(fn func(*bool)) {
+	a.scope.Batch(func() {
+		a.Update(fn)
+	})
+}
+
+func (a *AtomicBool) Snapshot() (restore func()) {
+	saved := a.value.Load()
+	return func() {
+		a.scope.Batch(func() {
+			a.Set(saved)
+		})
+	}
+}
+
+func (a *AtomicBool) unsubscribe(c computation) {
+	a.subs.remove(c)
+}
+
+// subscriberSet is the dependency-tracking half of a signal, for signal
+// types that store their value elsewhere.
+type subscriberSet struct {
+	mu   sync.Mutex
+	subs map[computation]struct{}
+}
+
+// track subscribes the engine's active listener, if any, to self.
+func (ss *subscriberSet) track(self subscribable, e *Engine) {
+	e.checkRead()
+	listener := e.currentListener()
+	if listener == nil {
+		return
+	}
+	ss.mu.Lock()
+	if ss.subs == nil {
+		ss.subs = make(map[computation]struct{})
+	}
+	ss.subs[listener] = struct{}{}
+	n := len(ss.subs)
+	ss.mu.Unlock()
+	e.checkSubscribers(n, "")
+	listener.addSource(self)
+}
+
+func (ss *subscriberSet) remove(c computation) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	delete(ss.subs, c)
+}
+
+func (ss *subscriberSet) notify(e *Engine) {
+	e.notifyAll(ss.snapshot())
+}
+
+// len returns the number of subscribers, without copying them.
+func (ss *subscriberSet) len() int {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	return len(ss.subs)
+}
+
+func (ss *subscriberSet) snapshot() []computation {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	subs := make([]computation, 0, len(ss.subs))
+	for sub := range ss.subs {
+		subs = append(subs, sub)
+	}
+	return subs
+}
@@ -0,0 +1,150 @@
+package signals
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestAtomicInt64_NotifiesOnChange(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	hits := NewAtomicInt64(s, 0)
+	var seen []int64
+	Effect(s, func() {
+		seen = append(seen, hits.Get())
+	})
+
+	hits.Set(5)
+	hits.Set(5)
+	hits.Update(func(v *int64) { *v += 2 })
+
+	if len(seen) != 3 || seen[1] != 5 || seen[2] != 7 {
+		t.Errorf("Expected effect to observe [0 5 7], got %v", seen)
+	}
+}
+
+func TestAtomicBool_NotifiesOnChange(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	ready := NewAtomicBool(s, false)
+	runCount := 0
+	Effect(s, func() {
+		_ = ready.Get()
+		runCount++
+	})
+
+	ready.Set(true)
+	ready.Set(true)
+	ready.Update(func(v *bool) { *v = !*v })
+
+	if runCount != 3 {
+		t.Errorf("Expected effect to run 3 times, ran %d times", runCount)
+	}
+	if ready.Get() {
+		t.Error("Expected value to be false after toggling")
+	}
+}
+
+func TestAtomicInt64_ConcurrentUpdates(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	hits := NewAtomicInt64(s, 0)
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for range 1000 {
+				hits.Update(func(v *int64) { *v++ })
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for range 1000 {
+				_ = hits.Get()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if val := hits.Get(); val != 8000 {
+		t.Errorf("Expected 8000 after concurrent updates, got %d", val)
+	}
+}
+
+func TestAtomic_WritesPassThroughMiddleware(t *testing.T) {
+	eng := Start(WithWriteMiddleware(func(next func(string, any)) func(string, any) {
+		return func(name string, v any) {
+			switch v := v.(type) {
+			case int64:
+				if v < 0 {
+					return
+				}
+				next(name, v*2)
+			default:
+				next(name, v)
+			}
+		}
+	}))
+	defer eng.Close()
+	s := eng.Scope()
+
+	n := NewAtomicInt64(s, 1)
+	n.Set(5)
+	n.Set(-1)
+	if got := n.Get(); got != 10 {
+		t.Errorf("Expected the doubled write to land and the vetoed one to be dropped, got %d", got)
+	}
+	flag := NewAtomicBool(s, false)
+	flag.Set(true)
+	if !flag.Get() {
+		t.Error("Expected the bool write to pass through unchanged")
+	}
+}
+
+func TestAtomic_StrictModeSeesWrites(t *testing.T) {
+	eng := Start(WithStrictMode())
+	defer eng.Close()
+	s := eng.Scope()
+
+	n := NewAtomicInt64(s, 1)
+	other := New(s, 2)
+	got := capturePanic(func() {
+		n.Update(func(v *int64) {
+			*v += int64(other.Get())
+		})
+	})
+	if got != ErrReadDuringWrite {
+		t.Errorf("Expected ErrReadDuringWrite, got %v", got)
+	}
+}
+
+func BenchmarkSignalGetParallel(b *testing.B) {
+	eng := Start()
+	defer eng.Close()
+	sig := New[int64](eng.Scope(), 1)
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = sig.Get()
+		}
+	})
+}
+
+func BenchmarkAtomicInt64GetParallel(b *testing.B) {
+	eng := Start()
+	defer eng.Close()
+	sig := NewAtomicInt64(eng.Scope(), 1)
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = sig.Get()
+		}
+	})
+}
@@ -0,0 +1,30 @@
+package signals
+
+import "slices"
+
+// WithReadAudit makes the engine record whether each named signal has ever
+// had a subscriber, so UnreadSignals can report likely dead state.
+func WithReadAudit() Option {
+	return func(e *Engine) {
+		e.auditReads = true
+	}
+}
+
+// UnreadSignals returns, in sorted order, the names of registered signals
+// that no computation has ever subscribed to. It returns nil unless the
+// engine was started with WithReadAudit.
+func (e *Engine) UnreadSignals() []string {
+	if !e.auditReads {
+		return nil
+	}
+	e.registryMu.Lock()
+	defer e.registryMu.Unlock()
+	var names []string
+	for name, sig := range e.registry {
+		if !sig.wasSubscribed() {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
@@ -0,0 +1,50 @@
+package signals
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestEngine_UnreadSignalsReportsOnlyUnread(t *testing.T) {
+	eng := Start(WithReadAudit())
+	defer eng.Close()
+	s := eng.Scope()
+
+	read := NewNamed(s, "read", 1)
+	_ = NewNamed(s, "unread", 2)
+	peeked := NewNamed(s, "peeked", 3)
+
+	Effect(s, func() {
+		_ = read.Get()
+	})
+	// Reads outside any computation don't count as subscriptions.
+	_ = peeked.Get()
+
+	want := []string{"peeked", "unread"}
+	if got := eng.UnreadSignals(); !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestEngine_UnreadSignalsRequiresAudit(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	_ = NewNamed(s, "unread", 1)
+	if got := eng.UnreadSignals(); got != nil {
+		t.Errorf("Expected nil without WithReadAudit, got %v", got)
+	}
+}
+
+func TestEngine_DisposedSignalsAreUnregistered(t *testing.T) {
+	eng := Start(WithReadAudit())
+	s := eng.Scope()
+
+	_ = NewNamed(s, "unread", 1)
+	eng.Close()
+
+	if got := eng.UnreadSignals(); len(got) != 0 {
+		t.Errorf("Expected no signals after dispose, got %v", got)
+	}
+}
@@ -0,0 +1,15 @@
+package signals
+
+// Bind keeps an external, imperative target in sync with src. It calls apply
+// with the current value immediately and again on every change. apply runs
+// untracked, so signals it reads don't become dependencies. The returned
+// stop detaches the binding; it is also detached when s is disposed.
+func Bind[T any](s *Scope, src Readonly[T], apply func(T)) (stop func()) {
+	stop = Effect(s, func() {
+		v := src.Get()
+		Untrack(s, func() {
+			apply(v)
+		})
+	})
+	return stop
+}
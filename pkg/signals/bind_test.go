@@ -0,0 +1,49 @@
+package signals
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBind_AppliesInitialValueAndChanges(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	label := New(s, "idle")
+	var applied []string
+	stop := Bind(s, label, func(v string) {
+		applied = append(applied, v)
+	})
+
+	label.Set("loading")
+	label.Set("done")
+	if want := []string{"idle", "loading", "done"}; !slices.Equal(applied, want) {
+		t.Fatalf("Expected %v, got %v", want, applied)
+	}
+
+	stop()
+	label.Set("idle")
+	if len(applied) != 3 {
+		t.Errorf("Expected stop to detach the binding, got %v", applied)
+	}
+}
+
+func TestBind_ApplyIsUntracked(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := New(s, 1)
+	other := New(s, 100)
+	calls := 0
+	Bind(s, src, func(v int) {
+		_ = other.Get()
+		calls++
+	})
+
+	other.Set(200)
+	if calls != 1 {
+		t.Errorf("Expected reads inside apply not to become dependencies, got %d calls", calls)
+	}
+}
@@ -0,0 +1,120 @@
+package signals
+
+import (
+	"errors"
+	"sync"
+)
+
+// getAndSubscribe registers c and snapshots the value under one lock, so no
+// write can land between the read and the subscription.
+func (s *signal[T]) getAndSubscribe(c computation) (T, uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.subscribers == nil {
+		s.subscribers = make(map[computation]struct{})
+	}
+	s.subscribers[c] = struct{}{}
+	return s.value, s.version.Load()
+}
+
+func (s *signal[T]) snapshot() (T, uint64) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.value, s.version.Load()
+}
+
+// OnChange calls fn with the new value every time r changes after the call,
+// outside of any tracking. The returned stop detaches it; it is also
+// detached when s is disposed.
+func OnChange[T any](s *Scope, r Readonly[T], fn func(T)) (stop func()) {
+	return watch(s, r, false, fn)
+}
+
+// ErrInvalidChannelBuffer is the panic value raised when ToChannel is given
+// a buffer smaller than one.
+var ErrInvalidChannelBuffer = errors.New("signals: channel buffer must be at least 1")
+
+// ToChannel returns a channel that receives r's current value followed by
+// its value after every change. Values arrive in write order and are never
+// repeated; writes that land while a delivery is in progress may be
+// coalesced into the latest value. Deliveries block once buffer values are
+// unread, so the consumer must keep up. buffer must be at least 1, since the
+// current value is sent before the channel is returned; smaller values panic
+// with ErrInvalidChannelBuffer. The returned stop detaches the channel
+// without closing it; it is also detached when s is disposed.
+func ToChannel[T any](s *Scope, r Readonly[T], buffer int) (<-chan T, func()) {
+	if buffer < 1 {
+		panic(ErrInvalidChannelBuffer)
+	}
+	ch := make(chan T, buffer)
+	stop := watch(s, r, true, func(v T) {
+		ch <- v
+	})
+	return ch, stop
+}
+
+// watch delivers r's changes to deliver, and its current value too if
+// initial is set.
+func watch[T any](s *Scope, r Readonly[T], initial bool, deliver func(T)) (stop func()) {
+	src, ok := r.(*signal[T])
+	if !ok {
+		// Fall back to an effect for derived sources such as memos, whose
+		// values only exist once computed.
+		first := true
+		stop = Effect(s, func() {
+			v := r.Get()
+			if first && !initial {
+				first = false
+				return
+			}
+			first = false
+			Untrack(s, func() {
+				deliver(v)
+			})
+		})
+		return stop
+	}
+
+	b := &bridge[T]{scope: s, src: src, deliver: deliver}
+	b.mu.Lock()
+	v, version := src.getAndSubscribe(b)
+	b.last = version
+	if initial {
+		deliver(v)
+	}
+	b.mu.Unlock()
+
+	var once sync.Once
+	stop = func() {
+		once.Do(func() {
+			src.unsubscribe(b)
+		})
+	}
+	onScopeCleanup(s, stop)
+	return stop
+}
+
+// bridge is a computation that forwards a source's writes to plain Go code,
+// delivering each write version at most once and in order.
+type bridge[T any] struct {
+	scope   *Scope
+	src     *signal[T]
+	deliver func(T)
+	mu      sync.Mutex
+	last    uint64
+}
+
+func (b *bridge[T]) notify() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	v, version := b.src.snapshot()
+	if version <= b.last {
+		return
+	}
+	b.last = version
+	Untrack(b.scope, func() {
+		b.deliver(v)
+	})
+}
+
+func (b *bridge[T]) addSource(subscribable) {}
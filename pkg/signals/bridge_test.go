@@ -0,0 +1,119 @@
+package signals
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestOnChange_CallsFnOnEachChange(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 0)
+	var seen []int
+	stop := OnChange(s, count, func(v int) {
+		seen = append(seen, v)
+	})
+
+	count.Set(1)
+	count.Set(2)
+	stop()
+	count.Set(3)
+
+	if want := []int{1, 2}; !slices.Equal(seen, want) {
+		t.Errorf("Expected %v, got %v", want, seen)
+	}
+}
+
+func TestOnChange_FallsBackForMemos(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 1)
+	doubled := Memo(s, func() int { return count.Get() * 2 })
+	var seen []int
+	OnChange(s, doubled, func(v int) {
+		seen = append(seen, v)
+	})
+
+	count.Set(2)
+	if want := []int{4}; !slices.Equal(seen, want) {
+		t.Errorf("Expected %v, got %v", want, seen)
+	}
+}
+
+func TestToChannel_SendsCurrentThenChanges(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 10)
+	ch, stop := ToChannel(s, count, 4)
+	defer stop()
+
+	count.Set(11)
+	count.Set(12)
+
+	for _, want := range []int{10, 11, 12} {
+		if got := <-ch; got != want {
+			t.Errorf("Expected %d, got %d", want, got)
+		}
+	}
+}
+
+func TestToChannel_SubscribeWhileWritingLosesNothing(t *testing.T) {
+	const writes = 2000
+	for range 20 {
+		eng := Start()
+		s := eng.Scope()
+		count := New(s, 0)
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for i := 1; i <= writes; i++ {
+				count.Set(i)
+			}
+		}()
+
+		ch, stop := ToChannel(s, count, writes+1)
+
+		var got []int
+		timeout := time.After(5 * time.Second)
+	receive:
+		for {
+			select {
+			case v := <-ch:
+				got = append(got, v)
+				if v == writes {
+					break receive
+				}
+			case <-timeout:
+				t.Fatalf("Timed out waiting for the final write; received %d values ending in %v", len(got), got[len(got)-1:])
+			}
+		}
+		<-done
+		stop()
+		eng.Close()
+
+		for i := 1; i < len(got); i++ {
+			if got[i] <= got[i-1] {
+				t.Fatalf("Expected strictly increasing values with no duplicates, got %d after %d", got[i], got[i-1])
+			}
+		}
+	}
+}
+
+func TestToChannel_RejectsUnbufferedChannel(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 0)
+	if got := capturePanic(func() { ToChannel(s, count, 0) }); got != ErrInvalidChannelBuffer {
+		t.Errorf("Expected ToChannel to panic with ErrInvalidChannelBuffer, got %v", got)
+	}
+}
@@ -0,0 +1,56 @@
+package signals
+
+// WithFlushBudget limits how many effect runs one flush hands the dispatcher
+// per turn. The passive effects a flush re-runs are given to the dispatcher
+// as a single task that runs the first n of them; before running those, it
+// queues a task holding the rest, so work queued in between, including
+// runs caused by the first n, gets a turn before the remainder continues.
+// The remainder keeps its original order, and nothing queued later runs
+// ahead of it.
+//
+// The budget only applies with a dispatcher, such as one set by
+// WithScheduler. Without one, effects run inline and there is no later turn
+// to yield to, so the budget is ignored. n <= 0 means no budget.
+func WithFlushBudget(n int) Option {
+	return func(e *Engine) {
+		e.flushBudget = n
+	}
+}
+
+// scheduleBudgeted hands effs to the dispatcher in turns of at most
+// e.flushBudget runs each.
+func (e *Engine) scheduleBudgeted(effs []*effect) {
+	var rest []*effect
+	for _, eff := range effs {
+		// Coalesce with runs already waiting on the dispatcher.
+		if !eff.queued.Swap(true) {
+			rest = append(rest, eff)
+		}
+	}
+	if len(rest) == 0 {
+		return
+	}
+	e.scheduled.Add(int64(len(rest)))
+
+	var turn func()
+	turn = func() {
+		n := min(e.flushBudget, len(rest))
+		now := rest[:n]
+		rest = rest[n:]
+		if len(rest) > 0 {
+			e.dispatch(turn)
+		}
+		// A panic doesn't skip the rest of the turn, which would leave
+		// those effects queued for good; the first is re-raised at the end.
+		var unhandled any
+		for _, eff := range now {
+			if r := eff.runDispatched(); r != nil && unhandled == nil {
+				unhandled = r
+			}
+		}
+		if unhandled != nil {
+			panic(unhandled)
+		}
+	}
+	e.dispatch(turn)
+}
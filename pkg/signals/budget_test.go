@@ -0,0 +1,81 @@
+package signals
+
+import "testing"
+
+func TestEngine_FlushBudgetYieldsRemainderToNextTurn(t *testing.T) {
+	var queue []func()
+	step := func() {
+		fn := queue[0]
+		queue = queue[1:]
+		fn()
+	}
+	eng := Start(WithFlushBudget(2), WithDispatchGoroutine(func(fn func()) {
+		queue = append(queue, fn)
+	}))
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 0)
+	var order []int
+	for i := range 5 {
+		Effect(s, func() {
+			if count.Get() > 0 {
+				order = append(order, i)
+			}
+		})
+	}
+
+	count.Set(1)
+	if len(queue) != 1 {
+		t.Fatalf("Expected the flush to hand the dispatcher one turn, got %d", len(queue))
+	}
+	step()
+	if len(order) != 2 || len(queue) != 1 {
+		t.Fatalf("Expected the first turn to run 2 effects and queue the rest, ran %v with %d queued", order, len(queue))
+	}
+	step()
+	if len(order) != 4 {
+		t.Fatalf("Expected the second turn to run 2 more, ran %v", order)
+	}
+	step()
+	if len(order) != 5 || len(queue) != 0 {
+		t.Fatalf("Expected the last turn to run the final effect, ran %v with %d queued", order, len(queue))
+	}
+	if p := eng.Pending(); !p.Idle() {
+		t.Errorf("Expected no pending work afterwards, got %+v", p)
+	}
+}
+
+func TestEngine_FlushBudgetTurnSurvivesPanic(t *testing.T) {
+	var queue []func()
+	eng := Start(WithFlushBudget(3), WithDispatchGoroutine(func(fn func()) {
+		queue = append(queue, fn)
+	}))
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 0)
+	ran := 0
+	Effect(s, func() {
+		if count.Get() == 1 {
+			panic("boom")
+		}
+	})
+	for range 2 {
+		Effect(s, func() {
+			_ = count.Get()
+			ran++
+		})
+	}
+
+	count.Set(1)
+	if got := capturePanic(queue[0]); got != "boom" {
+		t.Errorf("Expected the panic to be re-raised after the turn, got %v", got)
+	}
+	if ran != 4 {
+		t.Errorf("Expected the rest of the turn to run despite the panic, ran %d times", ran)
+	}
+	if p := eng.Pending(); !p.Idle() {
+		t.Errorf("Expected no pending work afterwards, got %+v", p)
+	}
+}
@@ -0,0 +1,158 @@
+package signals
+
+import (
+	"sync"
+	"time"
+)
+
+// Cache is a reactive key-value cache whose entries expire after a fixed TTL.
+// Reads through Get subscribe to the individual key, so writes, deletes and
+// expirations only re-run the computations that read that key.
+type Cache[K comparable, V any] struct {
+	scope   *Scope
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[K]cacheEntry[V]
+	// versions holds a signal per key that computations have read, dropped
+	// on the key's next change once nothing is subscribed to it.
+	versions map[K]*signal[uint64]
+	sweeper  Timer
+}
+
+type cacheEntry[V any] struct {
+	value   V
+	expires time.Time
+}
+
+// NewCache creates a cache whose entries expire ttl after they were last set.
+// Expired entries are swept on the engine's clock, notifying observers of
+// each expired key. The sweeper is stopped when s is disposed.
+func NewCache[K comparable, V any](s *Scope, ttl time.Duration) *Cache[K, V] {
+	c := &Cache[K, V]{
+		scope:    s,
+		ttl:      ttl,
+		entries:  make(map[K]cacheEntry[V]),
+		versions: make(map[K]*signal[uint64]),
+	}
+	onScopeCleanup(s, c.stopSweeper)
+	return c
+}
+
+// Get returns the value for k and whether it is present and unexpired. When
+// called from a computation it subscribes to changes of k.
+func (c *Cache[K, V]) Get(k K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// Subscribe under the lock, so bump can't drop the version in between.
+	if c.scope.engine.currentListener() != nil {
+		c.version(k).Get()
+	} else {
+		c.scope.engine.checkRead()
+	}
+	e, ok := c.entries[k]
+	if !ok || !c.scope.engine.clock.Now().Before(e.expires) {
+		var zero V
+		return zero, false
+	}
+	return e.value, true
+}
+
+// Set stores v under k, resetting its expiry.
+func (c *Cache[K, V]) Set(k K, v V) {
+	c.mu.Lock()
+	c.entries[k] = cacheEntry[V]{
+		value:   v,
+		expires: c.scope.engine.clock.Now().Add(c.ttl),
+	}
+	if c.sweeper == nil {
+		c.sweeper = c.scope.engine.clock.AfterFunc(c.ttl, c.sweep)
+	}
+	c.mu.Unlock()
+
+	c.bump(k)
+}
+
+// Delete removes k from the cache.
+func (c *Cache[K, V]) Delete(k K) {
+	c.mu.Lock()
+	_, ok := c.entries[k]
+	delete(c.entries, k)
+	c.mu.Unlock()
+
+	if ok {
+		c.bump(k)
+	}
+}
+
+// Len returns the number of entries currently held, including any that have
+// expired but not yet been swept. It is not reactive.
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// version returns the per-key signal that observers of k subscribe to.
+// Callers must hold c.mu.
+func (c *Cache[K, V]) version(k K) *signal[uint64] {
+	v, ok := c.versions[k]
+	if !ok {
+		v = New[uint64](c.scope, 0).(*signal[uint64])
+		c.versions[k] = v
+	}
+	return v
+}
+
+// bump notifies the observers of k. A version nothing is subscribed to any
+// more is dropped instead, so keys that are no longer read don't pile up.
+func (c *Cache[K, V]) bump(k K) {
+	c.mu.Lock()
+	v, ok := c.versions[k]
+	if ok && !v.hasSubscribers() {
+		delete(c.versions, k)
+		ok = false
+	}
+	c.mu.Unlock()
+
+	if ok {
+		v.Update(func(n *uint64) { *n++ })
+	}
+}
+
+// sweep removes expired entries and reschedules itself for the next expiry.
+func (c *Cache[K, V]) sweep() {
+	c.mu.Lock()
+	now := c.scope.engine.clock.Now()
+	var expired []K
+	var next time.Time
+	for k, e := range c.entries {
+		if !now.Before(e.expires) {
+			expired = append(expired, k)
+			delete(c.entries, k)
+			continue
+		}
+		if next.IsZero() || e.expires.Before(next) {
+			next = e.expires
+		}
+	}
+	c.sweeper = nil
+	if !next.IsZero() && c.scope.isLive.Load() {
+		c.sweeper = c.scope.engine.clock.AfterFunc(next.Sub(now), c.sweep)
+	}
+	c.mu.Unlock()
+
+	c.scope.Batch(func() {
+		for _, k := range expired {
+			c.bump(k)
+		}
+	})
+}
+
+func (c *Cache[K, V]) stopSweeper() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.sweeper != nil {
+		c.sweeper.Stop()
+		c.sweeper = nil
+	}
+}
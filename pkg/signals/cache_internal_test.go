@@ -0,0 +1,30 @@
+package signals
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCache_DropsVersionsOfUnobservedKeys(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	cache := NewCache[int, int](s, time.Minute)
+	for i := range 100 {
+		cache.Set(i, i)
+		_, _ = cache.Get(i)
+		cache.Delete(i)
+	}
+	stop := Effect(s, func() { _, _ = cache.Get(1000) })
+	cache.Set(1000, 1)
+	if n := len(cache.versions); n != 1 {
+		t.Errorf("Expected a version only for the observed key, got %d", n)
+	}
+
+	stop()
+	cache.Delete(1000)
+	if n := len(cache.versions); n != 0 {
+		t.Errorf("Expected the deleted key's version to be dropped once unobserved, got %d", n)
+	}
+}
@@ -0,0 +1,103 @@
+package signals_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgarvarela24/signals-go/pkg/signals"
+	"github.com/edgarvarela24/signals-go/pkg/signals/signalstest"
+)
+
+func TestCache_GetAndSet(t *testing.T) {
+	clock := signalstest.NewFakeClock(time.Time{})
+	eng := signals.Start(signals.WithClock(clock))
+	defer eng.Close()
+	s := eng.Scope()
+
+	cache := signals.NewCache[string, int](s, time.Minute)
+	if _, ok := cache.Get("a"); ok {
+		t.Fatal("Expected missing key to be absent")
+	}
+
+	cache.Set("a", 1)
+	if v, ok := cache.Get("a"); !ok || v != 1 {
+		t.Errorf("Expected (1, true), got (%d, %v)", v, ok)
+	}
+}
+
+func TestCache_ObserverRerunsWhenKeyExpires(t *testing.T) {
+	clock := signalstest.NewFakeClock(time.Time{})
+	eng := signals.Start(signals.WithClock(clock))
+	defer eng.Close()
+	s := eng.Scope()
+
+	cache := signals.NewCache[string, int](s, time.Minute)
+	cache.Set("a", 1)
+
+	var present []bool
+	signals.Effect(s, func() {
+		_, ok := cache.Get("a")
+		present = append(present, ok)
+	})
+
+	clock.Advance(30 * time.Second)
+	if len(present) != 1 {
+		t.Fatalf("Expected no re-run before expiry, got %v", present)
+	}
+
+	clock.Advance(30 * time.Second)
+	if len(present) != 2 || present[1] {
+		t.Errorf("Expected a re-run observing the key as absent, got %v", present)
+	}
+	if cache.Len() != 0 {
+		t.Errorf("Expected expired entry to be swept, got %d entries", cache.Len())
+	}
+}
+
+func TestCache_ExpiryIsPerKey(t *testing.T) {
+	clock := signalstest.NewFakeClock(time.Time{})
+	eng := signals.Start(signals.WithClock(clock))
+	defer eng.Close()
+	s := eng.Scope()
+
+	cache := signals.NewCache[string, int](s, time.Minute)
+	cache.Set("a", 1)
+	clock.Advance(30 * time.Second)
+	cache.Set("b", 2)
+
+	bRuns := 0
+	signals.Effect(s, func() {
+		_, _ = cache.Get("b")
+		bRuns++
+	})
+
+	clock.Advance(30 * time.Second)
+	if _, ok := cache.Get("a"); ok {
+		t.Error("Expected a to have expired")
+	}
+	if bRuns != 1 {
+		t.Errorf("Expected observer of b not to re-run when a expires, ran %d times", bRuns)
+	}
+
+	clock.Advance(30 * time.Second)
+	if _, ok := cache.Get("b"); ok {
+		t.Error("Expected b to have expired")
+	}
+	if bRuns != 2 {
+		t.Errorf("Expected observer of b to re-run when b expires, ran %d times", bRuns)
+	}
+}
+
+func TestCache_DisposeStopsSweeper(t *testing.T) {
+	clock := signalstest.NewFakeClock(time.Time{})
+	eng := signals.Start(signals.WithClock(clock))
+	s := eng.Scope()
+
+	cache := signals.NewCache[string, int](s, time.Minute)
+	cache.Set("a", 1)
+	eng.Close()
+
+	if clock.Pending() != 0 {
+		t.Errorf("Expected sweeper to be stopped on dispose, got %d pending timers", clock.Pending())
+	}
+}
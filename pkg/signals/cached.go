@@ -0,0 +1,75 @@
+package signals
+
+import (
+	"container/list"
+	"sync"
+)
+
+// CachedOption configures Cached.
+type CachedOption func(*cachedConfig)
+
+type cachedConfig struct {
+	maxEntries int
+}
+
+// MaxEntries bounds the number of arguments Cached remembers. Once full,
+// caching a new argument evicts the least recently used one.
+func MaxEntries(n int) CachedOption {
+	return func(c *cachedConfig) {
+		c.maxEntries = n
+	}
+}
+
+// Cached returns a memoized version of fn: each argument gets its own memo,
+// so a repeated call returns the cached result until a signal read while
+// computing it for that argument changes. Calls made inside a computation
+// subscribe it to that argument's result. By default entries are kept for
+// the life of s; see MaxEntries.
+func Cached[A comparable, R any](s *Scope, fn func(A) R, opts ...CachedOption) func(A) R {
+	if fn == nil {
+		panic(ErrNilMemoFunc)
+	}
+	var cfg cachedConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	type entry struct {
+		arg   A
+		memo  Readonly[R]
+		scope *Scope
+	}
+	var (
+		mu      sync.Mutex
+		entries = make(map[A]*list.Element)
+		lru     = list.New() // most recently used first
+	)
+	return func(a A) R {
+		mu.Lock()
+		el, ok := entries[a]
+		if ok {
+			lru.MoveToFront(el)
+		} else {
+			c := s.Child()
+			el = lru.PushFront(&entry{
+				arg:   a,
+				memo:  Memo(c, func() R { return fn(a) }),
+				scope: c,
+			})
+			entries[a] = el
+		}
+		var evicted []*Scope
+		for cfg.maxEntries > 0 && lru.Len() > cfg.maxEntries {
+			old := lru.Remove(lru.Back()).(*entry)
+			delete(entries, old.arg)
+			evicted = append(evicted, old.scope)
+		}
+		m := el.Value.(*entry).memo
+		mu.Unlock()
+
+		for _, c := range evicted {
+			c.Dispose()
+		}
+		return m.Get()
+	}
+}
@@ -0,0 +1,54 @@
+package signals
+
+import "testing"
+
+func TestCached_HitsUntilDependencyChanges(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	rate := New(s, 2)
+	calls := map[int]int{}
+	price := Cached(s, func(qty int) int {
+		calls[qty]++
+		return qty * rate.Get()
+	})
+
+	if price(3) != 6 || price(3) != 6 || price(4) != 8 {
+		t.Fatal("Expected cached results to match fn")
+	}
+	if calls[3] != 1 || calls[4] != 1 {
+		t.Errorf("Expected one computation per argument, got %v", calls)
+	}
+
+	rate.Set(5)
+	if got := price(3); got != 15 {
+		t.Errorf("Expected 15 after the dependency changed, got %d", got)
+	}
+	if calls[3] != 2 {
+		t.Errorf("Expected the entry to recompute once, got %d computations", calls[3])
+	}
+}
+
+func TestCached_MaxEntriesEvictsLeastRecentlyUsed(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	calls := map[string]int{}
+	upper := Cached(s, func(v string) string {
+		calls[v]++
+		return v + "!"
+	}, MaxEntries(2))
+
+	upper("a")
+	upper("b")
+	upper("a") // b is now least recently used.
+	upper("c") // Evicts b.
+	upper("a")
+	upper("b")
+
+	if calls["a"] != 1 || calls["b"] != 2 || calls["c"] != 1 {
+		t.Errorf("Expected only the evicted entry to recompute, got %v", calls)
+	}
+}
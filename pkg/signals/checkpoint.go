@@ -0,0 +1,40 @@
+package signals
+
+import "slices"
+
+// SignalID identifies a named signal; it is the name given to NewNamed.
+type SignalID string
+
+// Checkpoint records the write version of every named signal at a point in
+// time. It is much cheaper than a snapshot of values.
+type Checkpoint struct {
+	versions map[SignalID]uint64
+}
+
+// Checkpoint records the current write version of every named signal.
+func (e *Engine) Checkpoint() Checkpoint {
+	e.registryMu.Lock()
+	defer e.registryMu.Unlock()
+	cp := Checkpoint{versions: make(map[SignalID]uint64, len(e.registry))}
+	for name, sig := range e.registry {
+		cp.versions[SignalID(name)] = sig.writeVersion()
+	}
+	return cp
+}
+
+// ChangesSince returns, in sorted order, the named signals written since cp
+// was taken, including any registered after it. Writes skipped because the
+// value was equal don't count.
+func (e *Engine) ChangesSince(cp Checkpoint) []SignalID {
+	e.registryMu.Lock()
+	defer e.registryMu.Unlock()
+	var changed []SignalID
+	for name, sig := range e.registry {
+		id := SignalID(name)
+		if v, ok := cp.versions[id]; !ok || v != sig.writeVersion() {
+			changed = append(changed, id)
+		}
+	}
+	slices.Sort(changed)
+	return changed
+}
@@ -0,0 +1,51 @@
+package signals
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestEngine_ChangesSinceReportsWrittenSignals(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	a := NewNamed(s, "a", 1)
+	b := NewNamed(s, "b", "x")
+	_ = NewNamed(s, "c", true)
+
+	cp := eng.Checkpoint()
+	if got := eng.ChangesSince(cp); len(got) != 0 {
+		t.Fatalf("Expected no changes right after the checkpoint, got %v", got)
+	}
+
+	a.Set(2)
+	b.Update(func(v *string) { *v += "y" })
+
+	want := []SignalID{"a", "b"}
+	if got := eng.ChangesSince(cp); !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+
+	cp = eng.Checkpoint()
+	_ = NewNamed(s, "d", 0)
+	if got := eng.ChangesSince(cp); !slices.Equal(got, []SignalID{"d"}) {
+		t.Errorf("Expected a signal registered after the checkpoint to be reported, got %v", got)
+	}
+}
+
+func TestEngine_ChangesSinceCountsWritesNotValues(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	a := NewNamed(s, "a", 1)
+	cp := eng.Checkpoint()
+	a.Set(2)
+	a.Set(1)
+
+	// The value ended where it started, but it was written in between.
+	if got := eng.ChangesSince(cp); !slices.Equal(got, []SignalID{"a"}) {
+		t.Errorf("Expected a to be reported, got %v", got)
+	}
+}
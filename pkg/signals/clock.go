@@ -0,0 +1,45 @@
+package signals
+
+import "time"
+
+// Clock is the source of time for every time-based feature of an engine.
+// The default clock uses the time package; tests can substitute a
+// signalstest.FakeClock via WithClock to drive time deterministically.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+	AfterFunc(d time.Duration, f func()) Timer
+}
+
+// Timer is a pending call scheduled with Clock.AfterFunc.
+type Timer interface {
+	// Stop prevents the timer from firing. It reports whether the timer was
+	// still pending.
+	Stop() bool
+}
+
+// WithClock sets the clock the engine uses for all time-based features.
+func WithClock(c Clock) Option {
+	return func(e *Engine) {
+		e.clock = c
+	}
+}
+
+// Clock returns the clock the engine reads time through.
+func (e *Engine) Clock() Clock {
+	return e.clock
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+func (realClock) AfterFunc(d time.Duration, f func()) Timer {
+	return time.AfterFunc(d, f)
+}
@@ -0,0 +1,19 @@
+package signals_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgarvarela24/signals-go/pkg/signals"
+	"github.com/edgarvarela24/signals-go/pkg/signals/signalstest"
+)
+
+func TestEngine_WithClock(t *testing.T) {
+	clock := signalstest.NewFakeClock(time.Time{})
+	eng := signals.Start(signals.WithClock(clock))
+	defer eng.Close()
+
+	if eng.Clock() != clock {
+		t.Error("Expected engine to use the provided clock")
+	}
+}
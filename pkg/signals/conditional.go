@@ -0,0 +1,11 @@
+package signals
+
+// ConditionalGet reads r, subscribing the active listener to it only when
+// track is true, like choosing per read between Get and Peek. It suits
+// computations that decide at run time whether a read is a dependency.
+func ConditionalGet[T any](r Readonly[T], track bool) T {
+	if track {
+		return r.Get()
+	}
+	return r.Peek()
+}
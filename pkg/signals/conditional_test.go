@@ -0,0 +1,64 @@
+package signals
+
+import "testing"
+
+func TestConditionalGet_TracksOnlyWhenAsked(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	useFallback := New(s, false)
+	primary := New(s, 1)
+	fallback := New(s, 10)
+	doubled := Memo(s, func() int { return fallback.Get() * 2 })
+
+	runs := 0
+	var got int
+	Effect(s, func() {
+		runs++
+		on := useFallback.Get()
+		got = ConditionalGet[int](primary, !on) + ConditionalGet(doubled, on)
+	})
+	if got != 21 {
+		t.Fatalf("Expected 21, got %d", got)
+	}
+
+	fallback.Set(20)
+	if runs != 1 {
+		t.Errorf("Expected the untracked memo read not to subscribe, ran %d times", runs)
+	}
+	primary.Set(2)
+	if runs != 2 || got != 42 {
+		t.Errorf("Expected the tracked read to re-run with 42, ran %d times with %d", runs, got)
+	}
+
+	useFallback.Set(true)
+	if runs != 3 || got != 42 {
+		t.Fatalf("Expected a re-run with 42, ran %d times with %d", runs, got)
+	}
+	primary.Set(3)
+	if runs != 3 {
+		t.Errorf("Expected the now untracked signal read not to subscribe, ran %d times", runs)
+	}
+	fallback.Set(30)
+	if runs != 4 || got != 63 {
+		t.Errorf("Expected the now tracked memo read to re-run with 63, ran %d times with %d", runs, got)
+	}
+}
+
+func TestConditionalGet_UntrackedAtomicRead(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	a := NewAtomicInt64(s, 1)
+	runs := 0
+	Effect(s, func() {
+		runs++
+		_ = ConditionalGet[int64](a, false)
+	})
+	a.Set(2)
+	if runs != 1 {
+		t.Errorf("Expected an untracked atomic read not to subscribe, ran %d times", runs)
+	}
+}
@@ -0,0 +1,72 @@
+package signals
+
+import "reflect"
+
+// contextKey identifies a value provided on a scope: by type alone, or by
+// type and name for keyed values.
+type contextKey struct {
+	typ  reflect.Type
+	name string
+}
+
+// Provide makes value available to Use[T] on s and any scope derived from it.
+// Values are keyed by type, so providing a second T on the same scope
+// replaces the first. Use ProvideKeyed when several values share a type.
+func Provide[T any](s *Scope, value T) {
+	s.provide(contextKey{typ: reflect.TypeFor[T]()}, value)
+}
+
+// Use returns the nearest value of type T provided on s or an ancestor.
+func Use[T any](s *Scope) (T, bool) {
+	return lookup[T](s, contextKey{typ: reflect.TypeFor[T]()})
+}
+
+// ProvideKeyed makes value available to UseKeyed[T] under key, so that
+// values of the same type representing different things don't collide.
+// Keyed values are separate from those provided with Provide.
+func ProvideKeyed[T any](s *Scope, key string, value T) {
+	s.provide(contextKey{typ: reflect.TypeFor[T](), name: key}, value)
+}
+
+// UseKeyed returns the nearest value of type T provided under key on s or an
+// ancestor.
+func UseKeyed[T any](s *Scope, key string) (T, bool) {
+	return lookup[T](s, contextKey{typ: reflect.TypeFor[T](), name: key})
+}
+
+func (s *Scope) provide(key contextKey, value any) {
+	s.valuesMu.Lock()
+	defer s.valuesMu.Unlock()
+	if s.values == nil {
+		s.values = make(map[contextKey]any)
+	}
+	s.values[key] = value
+}
+
+// FromContextValue returns a computed value holding getter's result, which
+// is re-read whenever poll changes. It bridges values the reactive graph
+// can't observe, such as request-scoped context values, into it. getter runs
+// untracked, so poll is the only dependency. Like Memo it is lazy: getter
+// runs when the value is read after a change, not when poll changes.
+func FromContextValue[T any](s *Scope, getter func() T, poll Readonly[any]) Readonly[T] {
+	return Memo(s, func() (v T) {
+		_ = poll.Get()
+		Untrack(s, func() {
+			v = getter()
+		})
+		return v
+	})
+}
+
+func lookup[T any](s *Scope, key contextKey) (T, bool) {
+	for cur := s; cur != nil; cur = cur.parent {
+		cur.valuesMu.Lock()
+		v, ok := cur.values[key]
+		cur.valuesMu.Unlock()
+		if ok {
+			return v.(T), true
+		}
+	}
+	var zero T
+	return zero, false
+}
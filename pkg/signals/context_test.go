@@ -0,0 +1,77 @@
+package signals
+
+import (
+	"slices"
+	"testing"
+)
+
+type config struct {
+	Debug bool
+}
+
+func TestProvide_UseByType(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	if _, ok := Use[config](s); ok {
+		t.Fatal("Expected nothing to be provided yet")
+	}
+
+	Provide(s, config{Debug: true})
+	cfg, ok := Use[config](s)
+	if !ok || !cfg.Debug {
+		t.Errorf("Expected provided config, got %+v (ok=%v)", cfg, ok)
+	}
+}
+
+func TestProvideKeyed_DisambiguatesSameType(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	ProvideKeyed(s, "region", "eu-west-1")
+	ProvideKeyed(s, "bucket", "assets")
+	Provide(s, "unkeyed")
+
+	if v, ok := UseKeyed[string](s, "region"); !ok || v != "eu-west-1" {
+		t.Errorf("Expected region %q, got %q (ok=%v)", "eu-west-1", v, ok)
+	}
+	if v, ok := UseKeyed[string](s, "bucket"); !ok || v != "assets" {
+		t.Errorf("Expected bucket %q, got %q (ok=%v)", "assets", v, ok)
+	}
+	if v, ok := Use[string](s); !ok || v != "unkeyed" {
+		t.Errorf("Expected type-keyed value %q, got %q (ok=%v)", "unkeyed", v, ok)
+	}
+	if _, ok := UseKeyed[string](s, "missing"); ok {
+		t.Error("Expected unknown key to be absent")
+	}
+	if _, ok := UseKeyed[int](s, "region"); ok {
+		t.Error("Expected key with a different type to be absent")
+	}
+}
+
+func TestFromContextValue_RereadsWhenPollChanges(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	requestID := "req-1"
+	poll := New[any](s, 0)
+	current := FromContextValue(s, func() string { return requestID }, poll)
+
+	var seen []string
+	Effect(s, func() {
+		seen = append(seen, current.Get())
+	})
+
+	requestID = "req-2"
+	if got := current.Get(); got != "req-1" {
+		t.Errorf("Expected the value to stay cached until poll changes, got %q", got)
+	}
+
+	poll.Set(1)
+	if want := []string{"req-1", "req-2"}; !slices.Equal(seen, want) {
+		t.Errorf("Expected %v, got %v", want, seen)
+	}
+}
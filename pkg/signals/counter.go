@@ -0,0 +1,42 @@
+package signals
+
+var _ Readonly[int] = (*Counter)(nil)
+
+// Counter is a reactive integer with increment and decrement helpers. It
+// implements Readonly[int]; every change notifies subscribers, respecting
+// batches.
+type Counter struct {
+	sig Signal[int]
+}
+
+// NewCounter creates a counter starting at initial.
+func NewCounter(s *Scope, initial int) *Counter {
+	return &Counter{sig: New(s, initial)}
+}
+
+// Get returns the current count, subscribing the active computation.
+func (c *Counter) Get() int {
+	return c.sig.Get()
+}
+
+// Peek returns the current count without subscribing the active computation.
+func (c *Counter) Peek() int {
+	return c.sig.Peek()
+}
+
+// Inc adds one to the count.
+func (c *Counter) Inc() {
+	c.Add(1)
+}
+
+// Dec subtracts one from the count.
+func (c *Counter) Dec() {
+	c.Add(-1)
+}
+
+// Add adds n to the count, atomically with respect to other writers.
+func (c *Counter) Add(n int) {
+	c.sig.Update(func(v *int) {
+		*v += n
+	})
+}
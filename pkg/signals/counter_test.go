@@ -0,0 +1,114 @@
+package signals
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestCounter_IncDecAdd(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	c := NewCounter(s, 5)
+	var seen []int
+	Effect(s, func() {
+		seen = append(seen, c.Get())
+	})
+
+	c.Inc()
+	c.Dec()
+	c.Add(10)
+
+	want := []int{5, 6, 5, 15}
+	if len(seen) != len(want) {
+		t.Fatalf("Expected effect to observe %v, got %v", want, seen)
+	}
+	for i := range want {
+		if seen[i] != want[i] {
+			t.Errorf("Expected effect to observe %v, got %v", want, seen)
+			break
+		}
+	}
+}
+
+func TestCounter_BatchedIncrementsCoalesce(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	c := NewCounter(s, 0)
+	runCount := 0
+	Effect(s, func() {
+		_ = c.Get()
+		runCount++
+	})
+
+	s.Batch(func() {
+		for range 5 {
+			c.Inc()
+		}
+	})
+
+	if runCount != 2 {
+		t.Errorf("Expected one coalesced re-run, ran %d times", runCount)
+	}
+	if val := c.Get(); val != 5 {
+		t.Errorf("Expected count to be 5, got %d", val)
+	}
+}
+
+func TestCounter_ReadInsideComputationIsTracked(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	c := NewCounter(s, 1)
+	doubled := Memo(s, func() int { return c.Get() * 2 })
+	_ = doubled.Get()
+
+	c.Inc()
+	if val := doubled.Get(); val != 4 {
+		t.Errorf("Expected memo to recompute to 4, got %d", val)
+	}
+}
+
+func TestCounter_PeekDoesNotSubscribe(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	c := NewCounter(s, 1)
+	var r Readonly[int] = c
+	runs := 0
+	Effect(s, func() {
+		_ = r.Peek()
+		runs++
+	})
+
+	c.Inc()
+	if runs != 1 || r.Peek() != 2 {
+		t.Errorf("Expected Peek to read 2 without re-running the effect, ran %d times", runs)
+	}
+}
+
+func TestCounter_ConcurrentAddsAreNotLost(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+
+	c := NewCounter(eng.Scope(), 0)
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 1000 {
+				c.Inc()
+			}
+		}()
+	}
+	wg.Wait()
+	if val := c.Peek(); val != 8000 {
+		t.Errorf("Expected 8000 after concurrent increments, got %d", val)
+	}
+}
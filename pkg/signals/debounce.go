@@ -0,0 +1,108 @@
+package signals
+
+import (
+	"sync"
+	"time"
+)
+
+// Debounced returns a signal that follows src, but only takes on a new value
+// once src has stopped changing for d. Time is read from the engine's clock.
+func Debounced[T any](s *Scope, src Readonly[T], d time.Duration) Readonly[T] {
+	var initial T
+	Untrack(s, func() {
+		initial = src.Get()
+	})
+	out := New(s, initial)
+	clock := s.engine.clock
+
+	var (
+		mu      sync.Mutex
+		timer   Timer
+		started bool
+	)
+	stop := Effect(s, func() {
+		v := src.Get()
+		if !started {
+			started = true
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if timer != nil {
+			timer.Stop()
+		}
+		timer = clock.AfterFunc(d, func() {
+			out.Set(v)
+		})
+	})
+
+	onScopeCleanup(s, func() {
+		stop()
+		mu.Lock()
+		defer mu.Unlock()
+		if timer != nil {
+			timer.Stop()
+		}
+	})
+	return out
+}
+
+// DistinctDebounced is like Debounced, but only a change to a new value
+// restarts the wait: re-emitting the value already waiting leaves its timer
+// running, so a source that repeats itself can't postpone the update
+// forever. A change back to the value last propagated cancels the wait.
+func DistinctDebounced[T comparable](s *Scope, src Readonly[T], d time.Duration) Readonly[T] {
+	var initial T
+	Untrack(s, func() {
+		initial = src.Get()
+	})
+	out := New(s, initial)
+	clock := s.engine.clock
+
+	var (
+		mu         sync.Mutex
+		timer      Timer
+		waiting    T
+		propagated = initial
+		started    bool
+	)
+	stop := Effect(s, func() {
+		v := src.Get()
+		if !started {
+			started = true
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if timer != nil && v == waiting {
+			return
+		}
+		if timer != nil {
+			timer.Stop()
+			timer = nil
+		}
+		if v == propagated {
+			return
+		}
+		waiting = v
+		timer = clock.AfterFunc(d, func() {
+			mu.Lock()
+			timer = nil
+			propagated = v
+			mu.Unlock()
+			out.Set(v)
+		})
+	})
+
+	onScopeCleanup(s, func() {
+		stop()
+		mu.Lock()
+		defer mu.Unlock()
+		if timer != nil {
+			timer.Stop()
+		}
+	})
+	return out
+}
@@ -0,0 +1,91 @@
+package signals_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgarvarela24/signals-go/pkg/signals"
+	"github.com/edgarvarela24/signals-go/pkg/signals/signalstest"
+)
+
+func TestDebounced_EmitsAfterQuietPeriod(t *testing.T) {
+	clock := signalstest.NewFakeClock(time.Time{})
+	eng := signals.Start(signals.WithClock(clock))
+	defer eng.Close()
+	s := eng.Scope()
+
+	query := signals.New(s, "")
+	debounced := signals.Debounced(s, query, 100*time.Millisecond)
+
+	var seen []string
+	signals.Effect(s, func() {
+		seen = append(seen, debounced.Get())
+	})
+
+	query.Set("g")
+	clock.Advance(50 * time.Millisecond)
+	query.Set("go")
+	clock.Advance(50 * time.Millisecond)
+
+	if val := debounced.Get(); val != "" {
+		t.Fatalf("Expected debounced value to still be empty, got %q", val)
+	}
+
+	clock.Advance(50 * time.Millisecond)
+	if val := debounced.Get(); val != "go" {
+		t.Errorf("Expected debounced value to be %q, got %q", "go", val)
+	}
+	if len(seen) != 2 || seen[1] != "go" {
+		t.Errorf("Expected effect to observe only the settled value, got %v", seen)
+	}
+}
+
+func TestDebounced_DisposeStopsPendingTimer(t *testing.T) {
+	clock := signalstest.NewFakeClock(time.Time{})
+	eng := signals.Start(signals.WithClock(clock))
+	s := eng.Scope()
+
+	src := signals.New(s, 1)
+	_ = signals.Debounced(s, src, time.Second)
+	src.Set(2)
+
+	if clock.Pending() != 1 {
+		t.Fatalf("Expected one pending timer, got %d", clock.Pending())
+	}
+
+	eng.Close()
+	if clock.Pending() != 0 {
+		t.Errorf("Expected dispose to stop the pending timer, got %d pending", clock.Pending())
+	}
+}
+
+func TestDistinctDebounced_RepeatsDoNotExtendWindow(t *testing.T) {
+	clock := signalstest.NewFakeClock(time.Time{})
+	eng := signals.Start(signals.WithClock(clock))
+	defer eng.Close()
+	s := eng.Scope()
+
+	query := signals.New(s, "")
+	debounced := signals.DistinctDebounced(s, query, 100*time.Millisecond)
+
+	query.Set("go")
+	for range 3 {
+		clock.Advance(40 * time.Millisecond)
+		query.Set("go")
+	}
+	// 120ms after the first "go": Debounced would still be waiting.
+	if got := debounced.Get(); got != "go" {
+		t.Errorf("Expected repeated values not to extend the window, got %q", got)
+	}
+
+	query.Set("gop")
+	clock.Advance(60 * time.Millisecond)
+	query.Set("go")
+	clock.Advance(200 * time.Millisecond)
+	if got := debounced.Get(); got != "go" {
+		t.Errorf("Expected a return to the propagated value to cancel the wait, got %q", got)
+	}
+	if n := clock.Pending(); n != 0 {
+		t.Errorf("Expected no pending timers, got %d", n)
+	}
+}
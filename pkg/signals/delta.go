@@ -0,0 +1,60 @@
+package signals
+
+var _ Readonly[int] = (*DeltaSignal[int, int])(nil)
+
+// DeltaSignal is a signal changed by applying deltas in place, for large
+// values that are expensive to copy or diff. Subscribers are notified after
+// every Emit and can read just the delta with LastDelta. It implements
+// Readonly[T].
+type DeltaSignal[T, D any] struct {
+	sig   *signal[T]
+	apply func(*T, D)
+	last  D // guarded by sig.mu
+}
+
+// NewDelta creates a delta signal holding initial, changed by apply.
+func NewDelta[T, D any](s *Scope, initial T, apply func(*T, D)) *DeltaSignal[T, D] {
+	return &DeltaSignal[T, D]{
+		sig:   New(s, initial).(*signal[T]),
+		apply: apply,
+	}
+}
+
+// Get returns the current value, subscribing the active computation.
+func (d *DeltaSignal[T, D]) Get() T {
+	return d.sig.Get()
+}
+
+func (d *DeltaSignal[T, D]) Peek() T {
+	return d.sig.Peek()
+}
+
+// LastDelta returns the delta applied by the latest Emit, or D's zero value
+// if there hasn't been one, subscribing the active computation.
+func (d *DeltaSignal[T, D]) LastDelta() D {
+	d.sig.track()
+	d.sig.mu.RLock()
+	defer d.sig.mu.RUnlock()
+	return d.last
+}
+
+// Emit applies delta to the stored value in place and notifies subscribers,
+// respecting batches. Within a batch only the last delta is observable once
+// subscribers re-run.
+func (d *DeltaSignal[T, D]) Emit(delta D) {
+	s := d.sig
+	defer s.scope.engine.beginWrite()()
+
+	s.mu.Lock()
+	d.apply(&s.value, delta)
+	d.last = delta
+	s.version.Add(1)
+	subs := s.snapshotSubscribers()
+	s.mu.Unlock()
+
+	s.scope.engine.notifyAll(subs)
+}
+
+func (d *DeltaSignal[T, D]) subscriberSnapshot() []computation {
+	return d.sig.subscriberSnapshot()
+}
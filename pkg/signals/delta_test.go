@@ -0,0 +1,32 @@
+package signals
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDeltaSignal_AppliesAndExposesDelta(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	log := NewDelta(s, []string{"a"}, func(lines *[]string, added string) {
+		*lines = append(*lines, added)
+	})
+	var seen []string
+	Effect(s, func() {
+		if d := log.LastDelta(); d != "" {
+			seen = append(seen, d)
+		}
+	})
+
+	log.Emit("b")
+	log.Emit("c")
+
+	if want := []string{"b", "c"}; !slices.Equal(seen, want) {
+		t.Errorf("Expected subscribers to observe deltas %v, got %v", want, seen)
+	}
+	if want := []string{"a", "b", "c"}; !slices.Equal(log.Get(), want) {
+		t.Errorf("Expected value %v, got %v", want, log.Get())
+	}
+}
@@ -0,0 +1,81 @@
+package signals
+
+import "sync"
+
+// disposer runs scope disposals off the caller's goroutine, one at a time
+// and in the order they were requested.
+type disposer struct {
+	mu      sync.Mutex
+	queue   []func()
+	running bool
+	// closed is set by Close before it waits on pending, after which
+	// disposals run inline.
+	closed  bool
+	pending sync.WaitGroup
+}
+
+// WithAsyncDispose makes Scope.Dispose hand a scope's cleanups to a worker
+// goroutine owned by the engine and return immediately, so disposing a large
+// scope doesn't stall the caller. The scope counts as disposed as soon as
+// Dispose returns: the effects it and its descendants own stop reacting to
+// changes at once, and only cleanups and OnDispose hooks are deferred.
+//
+// Within a scope, ordering is unchanged: cleanups run by priority, child
+// scopes are disposed inline with their parent, and OnDispose hooks run
+// last. Separate Dispose calls are processed one at a time, in call order,
+// concurrently with the rest of the program, so cleanups must be safe to run
+// from another goroutine. Close waits for every queued disposal to finish
+// before disposing the root scope, which it does inline; a Dispose that
+// comes after Close has started waiting runs inline too.
+func WithAsyncDispose() Option {
+	return func(e *Engine) {
+		e.asyncDispose = true
+	}
+}
+
+// disposeAsync queues run on the disposal worker, starting it if idle. It
+// reports false, without queueing, once Close has started waiting for the
+// worker.
+func (e *Engine) disposeAsync(run func()) bool {
+	d := &e.disposer
+	d.mu.Lock()
+	if d.closed {
+		d.mu.Unlock()
+		return false
+	}
+	d.pending.Add(1)
+	d.queue = append(d.queue, run)
+	start := !d.running
+	d.running = true
+	d.mu.Unlock()
+	if start {
+		go d.drain()
+	}
+	return true
+}
+
+// close makes later disposals run inline and waits for the queued ones.
+func (d *disposer) close() {
+	d.mu.Lock()
+	d.closed = true
+	d.mu.Unlock()
+	d.pending.Wait()
+}
+
+func (d *disposer) drain() {
+	for {
+		d.mu.Lock()
+		if len(d.queue) == 0 {
+			d.queue = nil
+			d.running = false
+			d.mu.Unlock()
+			return
+		}
+		run := d.queue[0]
+		d.queue = d.queue[1:]
+		d.mu.Unlock()
+
+		run()
+		d.pending.Done()
+	}
+}
@@ -0,0 +1,43 @@
+package signals
+
+import "sync"
+
+// Disposer collects teardown functions, such as the stop funcs returned by
+// Effect and Bind, so they can be released together. The zero value is ready
+// to use.
+type Disposer struct {
+	mu       sync.Mutex
+	fns      []func()
+	disposed bool
+}
+
+// Add registers fn to run on Dispose. If the disposer has already been
+// disposed, fn runs immediately.
+func (d *Disposer) Add(fn func()) {
+	d.mu.Lock()
+	if d.disposed {
+		d.mu.Unlock()
+		fn()
+		return
+	}
+	d.fns = append(d.fns, fn)
+	d.mu.Unlock()
+}
+
+// Dispose runs every added function once, in reverse order of addition.
+// Calling it again is a no-op.
+func (d *Disposer) Dispose() {
+	d.mu.Lock()
+	if d.disposed {
+		d.mu.Unlock()
+		return
+	}
+	d.disposed = true
+	fns := d.fns
+	d.fns = nil
+	d.mu.Unlock()
+
+	for i := len(fns) - 1; i >= 0; i-- {
+		fns[i]()
+	}
+}
@@ -0,0 +1,53 @@
+package signals
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDisposer_RunsAllOnceInReverse(t *testing.T) {
+	var d Disposer
+	var order []int
+	for i := range 3 {
+		d.Add(func() { order = append(order, i) })
+	}
+
+	d.Dispose()
+	d.Dispose()
+
+	if want := []int{2, 1, 0}; !slices.Equal(order, want) {
+		t.Errorf("Expected each function to run once in reverse order %v, got %v", want, order)
+	}
+}
+
+func TestDisposer_AddAfterDisposeRunsImmediately(t *testing.T) {
+	var d Disposer
+	d.Dispose()
+
+	ran := false
+	d.Add(func() { ran = true })
+	if !ran {
+		t.Error("Expected function added after Dispose to run immediately")
+	}
+}
+
+func TestDisposer_StopsEffects(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 0)
+	runCount := 0
+	var d Disposer
+	d.Add(Effect(s, func() {
+		_ = count.Get()
+		runCount++
+	}))
+	d.Add(Bind(s, count, func(int) { runCount++ }))
+
+	d.Dispose()
+	count.Set(1)
+	if runCount != 2 {
+		t.Errorf("Expected disposed effects not to re-run, ran %d times", runCount)
+	}
+}
@@ -0,0 +1,57 @@
+package signals
+
+import "sync"
+
+// DistinctCountOption configures DistinctCount.
+type DistinctCountOption func(*distinctCountConfig)
+
+type distinctCountConfig struct {
+	maxTracked int
+}
+
+// MaxTracked bounds the set of values DistinctCount remembers to n. Once n
+// distinct values have been seen, further new values are not recorded and
+// the count stays at n, so it reads as "at least n". n <= 0 means no bound,
+// which is the default.
+func MaxTracked(n int) DistinctCountOption {
+	return func(c *distinctCountConfig) {
+		c.maxTracked = n
+	}
+}
+
+// DistinctCount returns a signal holding the number of distinct values src
+// has taken since DistinctCount was called, starting with its current value.
+// Every value seen is kept in a set for the life of s, so an unbounded
+// source should be capped with MaxTracked.
+//
+// DistinctCount observes src through an effect, so a value that is written
+// and overwritten within one batch is never seen.
+func DistinctCount[T comparable](s *Scope, src Readonly[T], opts ...DistinctCountOption) Readonly[int] {
+	var cfg distinctCountConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	var mu sync.Mutex
+	seen := make(map[T]struct{})
+	out := New(s, 0)
+	Effect(s, func() {
+		v := src.Get()
+
+		mu.Lock()
+		_, ok := seen[v]
+		full := cfg.maxTracked > 0 && len(seen) >= cfg.maxTracked
+		if !ok && !full {
+			seen[v] = struct{}{}
+		}
+		n := len(seen)
+		mu.Unlock()
+
+		Untrack(s, func() {
+			if out.Get() != n {
+				out.Set(n)
+			}
+		})
+	})
+	return out
+}
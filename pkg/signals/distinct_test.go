@@ -0,0 +1,33 @@
+package signals
+
+import "testing"
+
+func TestDistinctCount_CountsUniqueValues(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := New(s, 1)
+	count := DistinctCount(s, src)
+	for _, v := range []int{2, 1, 3, 2} {
+		src.Set(v)
+	}
+	if got := count.Get(); got != 3 {
+		t.Errorf("Expected 3 distinct values after 1,2,1,3,2, got %d", got)
+	}
+}
+
+func TestDistinctCount_MaxTrackedSaturates(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := New(s, 0)
+	count := DistinctCount(s, src, MaxTracked(2))
+	for v := 1; v <= 5; v++ {
+		src.Set(v)
+	}
+	if got := count.Get(); got != 2 {
+		t.Errorf("Expected the count to stop at the cap of 2, got %d", got)
+	}
+}
@@ -0,0 +1,88 @@
+package signals
+
+import "sync"
+
+// DynamicSum returns the sum of the values of a changing set of sources.
+// It subscribes to each source in the list individually, so a change to one
+// source adjusts the sum by that source's difference alone, and a change to
+// the list only subscribes to added sources and unsubscribes from removed
+// ones. A source listed more than once counts once per occurrence.
+func DynamicSum(s *Scope, sources Readonly[[]Readonly[int]]) Readonly[int] {
+	type entry struct {
+		count int // occurrences in the list
+		last  int
+		scope *Scope
+	}
+	var (
+		mu      sync.Mutex
+		sum     int
+		entries = make(map[Readonly[int]]*entry)
+		out     Signal[int]
+	)
+
+	// watch subscribes to src, keeping its contribution to sum current.
+	watch := func(src Readonly[int], e *entry) {
+		started := false
+		Effect(e.scope, func() {
+			v := src.Get()
+			mu.Lock()
+			sum += (v - e.last) * e.count
+			e.last = v
+			total := sum
+			mu.Unlock()
+			if started {
+				out.Set(total)
+			}
+			started = true
+		})
+	}
+
+	Effect(s, func() {
+		list := sources.Get()
+		counts := make(map[Readonly[int]]int, len(list))
+		for _, src := range list {
+			counts[src]++
+		}
+
+		Untrack(s, func() {
+			var removed []*Scope
+			mu.Lock()
+			for src, e := range entries {
+				if _, ok := counts[src]; !ok {
+					sum -= e.last * e.count
+					delete(entries, src)
+					removed = append(removed, e.scope)
+				}
+			}
+			added := make(map[Readonly[int]]*entry)
+			for src, n := range counts {
+				if e, ok := entries[src]; ok {
+					sum += e.last * (n - e.count)
+					e.count = n
+					continue
+				}
+				e := &entry{count: n, scope: s.Child()}
+				entries[src] = e
+				added[src] = e
+			}
+			mu.Unlock()
+
+			for _, c := range removed {
+				c.Dispose()
+			}
+			for src, e := range added {
+				watch(src, e)
+			}
+		})
+
+		mu.Lock()
+		total := sum
+		mu.Unlock()
+		if out == nil {
+			out = New(s, total)
+			return
+		}
+		out.Set(total)
+	})
+	return out
+}
@@ -0,0 +1,61 @@
+package signals
+
+import "testing"
+
+func TestDynamicSum_TracksSourcesAndValues(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	a := New(s, 1)
+	b := New(s, 10)
+	c := New(s, 100)
+	list := New(s, []Readonly[int]{a, b})
+	sum := DynamicSum(s, list)
+
+	check := func(want int, what string) {
+		t.Helper()
+		if got := sum.Get(); got != want {
+			t.Errorf("Expected %d after %s, got %d", want, what, got)
+		}
+	}
+	check(11, "creation")
+
+	a.Set(2)
+	check(12, "changing a source")
+
+	list.Set([]Readonly[int]{a, b, c})
+	check(112, "adding a source")
+
+	list.Set([]Readonly[int]{b, c, c})
+	check(210, "removing a source and listing one twice")
+
+	a.Set(1000)
+	check(210, "changing a removed source")
+
+	c.Set(5)
+	check(20, "changing a source listed twice")
+
+	if n := len(Subscribers[int](a)); n != 0 {
+		t.Errorf("Expected the removed source to have no subscribers, got %d", n)
+	}
+}
+
+func TestDynamicSum_NotifiesOncePerSourceChange(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	a := New(s, 1)
+	b := New(s, 2)
+	sum := DynamicSum(s, New(s, []Readonly[int]{a, b}))
+	var seen []int
+	Effect(s, func() {
+		seen = append(seen, sum.Get())
+	})
+
+	b.Set(5)
+	if len(seen) != 2 || seen[1] != 6 {
+		t.Errorf("Expected [3 6], got %v", seen)
+	}
+}
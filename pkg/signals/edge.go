@@ -0,0 +1,63 @@
+package signals
+
+import "sync/atomic"
+
+// Edge detects transitions of src. rising pulses true when a write takes
+// src from false to true, and falling when one takes it from true to false.
+// A pulse lasts until the write that raised it has finished propagating, so
+// an effect depending on an edge signal sees true once per transition, and
+// reads made afterwards see false. With WithDispatchGoroutine the pulse ends
+// on the consumer's loop, after the runs the transition queued. Both start
+// out false.
+//
+// Edge observes src through an effect, so a batch that flips src and flips
+// it back is seen as no transition at all.
+func Edge(s *Scope, src Readonly[bool]) (rising Readonly[bool], falling Readonly[bool]) {
+	var prev bool
+	Untrack(s, func() {
+		prev = src.Get()
+	})
+	up := New(s, false)
+	down := New(s, false)
+
+	// gen counts transitions, so the reset of an earlier pulse doesn't end a
+	// later one.
+	var gen atomic.Uint64
+	started := false
+	Effect(s, func() {
+		v := src.Get()
+		if !started {
+			started = true
+			return
+		}
+		if v == prev {
+			return
+		}
+		prev = v
+		edge, other := up, down
+		if !v {
+			edge, other = down, up
+		}
+		g := gen.Add(1)
+		Untrack(s, func() {
+			s.Batch(func() {
+				other.Set(false)
+				edge.Set(true)
+			})
+		})
+
+		reset := func() {
+			if gen.Load() == g {
+				edge.Set(false)
+			}
+		}
+		// The dispatcher runs work in the order received, so the reset
+		// comes after the runs the pulse queued.
+		if dispatch := s.engine.dispatch; dispatch != nil {
+			dispatch(reset)
+		} else {
+			s.engine.queueMicrotask(reset)
+		}
+	})
+	return up, down
+}
@@ -0,0 +1,74 @@
+package signals
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestEdge_FiresOnTransitions(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	button := New(s, false)
+	rising, falling := Edge(s, button)
+	var events []string
+	Effect(s, func() {
+		if rising.Get() {
+			events = append(events, "rise")
+		}
+	})
+	Effect(s, func() {
+		if falling.Get() {
+			events = append(events, "fall")
+		}
+	})
+
+	button.Set(true)
+	if rising.Get() {
+		t.Error("Expected the rising edge to be reset once the write propagated")
+	}
+	button.Set(false)
+	button.Set(true)
+
+	if want := []string{"rise", "fall", "rise"}; !slices.Equal(events, want) {
+		t.Errorf("Expected %v, got %v", want, events)
+	}
+	if rising.Get() || falling.Get() {
+		t.Error("Expected both edges to be reset after the last write")
+	}
+}
+
+func TestEdge_PulseOutlivesDispatchedRuns(t *testing.T) {
+	var queue []func()
+	eng := Start(WithDispatchGoroutine(func(fn func()) {
+		queue = append(queue, fn)
+	}))
+	defer eng.Close()
+	s := eng.Scope()
+	drain := func() {
+		for len(queue) > 0 {
+			fn := queue[0]
+			queue = queue[1:]
+			fn()
+		}
+	}
+
+	button := New(s, false)
+	rising, _ := Edge(s, button)
+	rises := 0
+	Effect(s, func() {
+		if rising.Get() {
+			rises++
+		}
+	})
+
+	button.Set(true)
+	drain()
+	if rises != 1 {
+		t.Errorf("Expected the dispatched run to see the rising edge once, saw it %d times", rises)
+	}
+	if rising.Get() {
+		t.Error("Expected the rising edge to be reset after the queued runs")
+	}
+}
@@ -1,6 +1,15 @@
 package signals
 
-import "sync"
+import (
+	"errors"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrNilEffectFunc is the panic value raised when an effect is created with
+// a nil function.
+var ErrNilEffectFunc = errors.New("signals: effect function must not be nil")
 
 // A computation is anything that can be subscribed to a signal.
 type computation interface {
@@ -14,6 +23,34 @@ type effect struct {
 	scope   *Scope
 	sources map[subscribable]struct{}
 	mu      sync.Mutex
+	queued  atomic.Bool
+
+	// id identifies the effect within its engine, in creation order.
+	id uint64
+	// name labels the effect for debugging.
+	name string
+
+	// skip, if set, is consulted on every notification; returning true
+	// drops the re-run and keeps the current dependencies.
+	skip func() bool
+
+	// layout effects re-run as soon as they are notified; other effects
+	// wait for the passive phase of the propagation.
+	layout bool
+	// deferred is set while the effect waits for the passive phase.
+	deferred atomic.Bool
+
+	// afterRun holds callbacks run each time fn returns, guarded by mu.
+	afterRun []func()
+	// manual holds the dependencies added with EffectHandle.Track, which
+	// are re-subscribed after every run. Guarded by mu.
+	manual []Dependency
+	// runCleanups holds the OnCleanup callbacks registered by the current
+	// run, called before the next one. Guarded by mu.
+	runCleanups []func()
+	// stopped is set for good once the effect is stopped, so runs that were
+	// already queued, in a batch or the dispatcher, don't resubscribe it.
+	stopped atomic.Bool
 }
 
 func (e *effect) addSource(s subscribable) {
@@ -27,35 +64,398 @@ func (e *effect) addSource(s subscribable) {
 
 func (e *effect) cleanup() {
 	e.mu.Lock()
-	defer e.mu.Unlock()
 	for s := range e.sources {
 		s.unsubscribe(e)
 	}
 	e.sources = nil // Allow GC
+	fns := e.runCleanups
+	e.runCleanups = nil
+	e.mu.Unlock()
+
+	for _, fn := range slices.Backward(fns) {
+		fn()
+	}
+}
+
+// halt stops the effect reacting to changes without cleaning it up, which
+// is left to stop.
+func (e *effect) halt() {
+	e.stopped.Store(true)
+}
+
+// stop detaches the effect for good.
+func (e *effect) stop() {
+	e.stopped.Store(true)
+	e.cleanup()
 }
 
 func (e *effect) notify() {
+	if e.stopped.Load() || (e.skip != nil && e.skip()) {
+		return
+	}
+	if e.layout {
+		e.run()
+		return
+	}
+	if e.scope.engine.deferPassive(e) {
+		return
+	}
+	e.schedule()
+}
+
+// schedule re-runs the effect now, or hands the run to the dispatcher.
+func (e *effect) schedule() {
+	if e.stopped.Load() {
+		return
+	}
+	dispatch := e.scope.engine.dispatch
+	if dispatch == nil {
+		e.run()
+		return
+	}
+	// Coalesce notifications that arrive before the dispatched run happens.
+	if e.queued.Swap(true) {
+		return
+	}
+	e.scope.engine.scheduled.Add(1)
+	dispatch(e.runScheduled)
+}
+
+// runScheduled performs a run handed to the dispatcher. As in propagate, a
+// panic is passed to the error handler as an ErrSubscriberPanic, and only
+// re-raised if there is none.
+func (e *effect) runScheduled() {
+	if r := e.runDispatched(); r != nil {
+		panic(r)
+	}
+}
+
+// runDispatched is runScheduled, returning the panic no handler took instead
+// of re-raising it.
+func (e *effect) runDispatched() (unhandled any) {
+	defer e.scope.engine.finishScheduled()
+	e.queued.Store(false)
+	if e.stopped.Load() {
+		return nil
+	}
+	return e.scope.engine.runGuarded(e.run)
+}
+
+func (e *effect) run() {
+	if e.stopped.Load() {
+		return
+	}
 	e.cleanup() // Clean up old dependencies before re-running
-	e.scope.engine.pushListener(e)
+	e.runTracked()
+	if e.stopped.Load() {
+		// Stopped while running: drop what this run subscribed to.
+		e.cleanup()
+		return
+	}
+	e.mu.Lock()
+	hooks := e.afterRun
+	e.mu.Unlock()
+	for _, fn := range hooks {
+		fn()
+	}
+}
+
+func (e *effect) runTracked() {
+	id := e.scope.engine.pushListener(e)
+	defer e.scope.engine.popListener(id)
 	e.fn()
-	e.scope.engine.popListener()
+
+	e.mu.Lock()
+	manual := e.manual
+	e.mu.Unlock()
+	for _, d := range manual {
+		d.touch()
+	}
+}
+
+// EffectOption configures an effect created with Effect.
+type EffectOption func(*effectConfig)
+
+type effectConfig struct {
+	deferInitial bool
+	name         string
+	layout       bool
+}
+
+// RunOnCreate controls whether Effect runs fn synchronously before
+// returning, which is the default. With RunOnCreate(false) the initial run is
+// delivered like a change notification instead: it joins the open batch and
+// runs when it flushes, or is handed to the dispatcher set by
+// WithDispatchGoroutine. With neither in play it still runs immediately,
+// since there is nothing to defer it to.
+func RunOnCreate(run bool) EffectOption {
+	return func(c *effectConfig) {
+		c.deferInitial = !run
+	}
+}
+
+// Name labels an effect for debugging.
+func Name(name string) EffectOption {
+	return func(c *effectConfig) {
+		c.name = name
+	}
 }
 
 // Effect registers a function to be run when its dependencies change.
-func Effect(s *Scope, fn func()) (stop func()) {
-	e := &effect{fn: fn, scope: s}
-	e.notify()
-	return e.cleanup
+// By default it runs once immediately, on the calling goroutine, to collect
+// its dependencies; see RunOnCreate. When a change propagates, effects run
+// after every LayoutEffect it reaches. The effect stops when s is disposed.
+func Effect(s *Scope, fn func(), opts ...EffectOption) (stop func()) {
+	e := newEffect(s, fn, opts)
+	s.addCleanup(cleanupEntry{fn: e.stop, halt: e.halt})
+	return e.stop
+}
+
+func newEffect(s *Scope, fn func(), opts []EffectOption) *effect {
+	if fn == nil {
+		panic(ErrNilEffectFunc)
+	}
+	var cfg effectConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	e := &effect{fn: fn, scope: s, id: s.engine.nextEffectID.Add(1), name: cfg.name, layout: cfg.layout}
+	if cfg.deferInitial {
+		s.engine.notifyAll([]computation{e})
+	} else {
+		e.firstRun()
+	}
+	return e
+}
+
+// firstRun performs the effect's initial run. If it panics, the caller never
+// gets a way to stop the effect, so it is stopped before the panic goes on.
+func (e *effect) firstRun() {
+	defer func() {
+		if r := recover(); r != nil {
+			e.stop()
+			panic(r)
+		}
+	}()
+	e.run()
+}
+
+// LayoutEffect is like Effect, but when a change propagates it re-runs
+// synchronously in the layout phase, before any Effect reached by the same
+// change runs, and never goes through the dispatcher. Use it for work, such
+// as measurement, that other effects rely on.
+func LayoutEffect(s *Scope, fn func(), opts ...EffectOption) (stop func()) {
+	opts = append(opts, func(c *effectConfig) {
+		c.layout = true
+	})
+	return Effect(s, fn, opts...)
+}
+
+// ErrEffectStopped is returned when reparenting an effect that was already
+// stopped.
+var ErrEffectStopped = errors.New("signals: effect is stopped")
+
+// EffectHandle controls an effect created with EffectWithHandle.
+type EffectHandle struct {
+	e       *effect
+	mu      sync.Mutex
+	scope   *Scope
+	stopped bool
+	done    chan struct{}
+}
+
+// EffectWithHandle is like Effect, but the effect is also stopped when s is
+// disposed, and the returned handle can move it to another scope.
+func EffectWithHandle(s *Scope, fn func(), opts ...EffectOption) *EffectHandle {
+	h := &EffectHandle{e: newEffect(s, fn, opts), scope: s}
+	s.addCleanup(cleanupEntry{fn: h.Stop, key: h, halt: h.e.halt})
+	return h
+}
+
+// ID returns the effect's identifier: its position in the order effects
+// were created on the engine, starting at 1. IDs are never reused, and are
+// the same from run to run of a program that creates effects in the same
+// order, so they can correlate log lines.
+func (h *EffectHandle) ID() uint64 {
+	return h.e.id
+}
+
+// AfterRun registers fn to be called each time the effect finishes a run,
+// outside of any tracking. fn is not called for runs that panic.
+func (h *EffectHandle) AfterRun(fn func()) {
+	h.e.mu.Lock()
+	defer h.e.mu.Unlock()
+	h.e.afterRun = append(h.e.afterRun, fn)
 }
 
-// Untrack prevents a signal read from creating a dependency.
+// Done returns a channel that receives a value after the effect finishes a
+// run. The channel has a buffer of one, so runs that complete while an
+// earlier pulse is unread are coalesced into it.
+func (h *EffectHandle) Done() <-chan struct{} {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.done == nil {
+		done := make(chan struct{}, 1)
+		h.done = done
+		h.AfterRun(func() {
+			select {
+			case done <- struct{}{}:
+			default:
+			}
+		})
+	}
+	return h.done
+}
+
+// Track subscribes the effect to d without re-running it, so a change to d
+// re-runs the effect as if its body had read it. Tracked dependencies are
+// kept across runs: each run discovers its dependencies afresh from the
+// body, then re-subscribes to every tracked one, until it is removed with
+// Untrack.
+func (h *EffectHandle) Track(d Dependency) {
+	e := h.e
+	e.mu.Lock()
+	if !slices.ContainsFunc(e.manual, d.same) {
+		e.manual = append(slices.Clip(e.manual), d)
+	}
+	e.mu.Unlock()
+
+	e.scope.engine.withListener(e, d.touch)
+}
+
+// Untrack unsubscribes the effect from d without re-running it, and stops
+// re-subscribing to it if it was added with Track. If the body itself reads
+// d, the next run subscribes to it again.
+func (h *EffectHandle) Untrack(d Dependency) {
+	e := h.e
+	e.mu.Lock()
+	e.manual = slices.DeleteFunc(slices.Clone(e.manual), d.same)
+	e.mu.Unlock()
+
+	// Find d's sources by touching it with a throwaway listener.
+	var rec sourceRecorder
+	e.scope.engine.withListener(&rec, d.touch)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, src := range rec.sources {
+		src.unsubscribe(&rec)
+		if _, ok := e.sources[src]; ok {
+			src.unsubscribe(e)
+			delete(e.sources, src)
+		}
+	}
+}
+
+// sourceRecorder is a computation that only records what it subscribes to.
+type sourceRecorder struct {
+	sources []subscribable
+}
+
+func (r *sourceRecorder) notify() {}
+
+func (r *sourceRecorder) addSource(s subscribable) {
+	r.sources = append(r.sources, s)
+}
+
+// Stop detaches the effect from its dependencies. It is safe to call more
+// than once.
+func (h *EffectHandle) Stop() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.stopLocked()
+}
+
+func (h *EffectHandle) stopLocked() {
+	if h.stopped {
+		return
+	}
+	h.stopped = true
+	h.scope.removeCleanup(h)
+	h.e.stop()
+}
+
+// Reparent moves the effect's lifetime from its current scope to newScope,
+// which must belong to the same engine: disposing newScope stops the effect,
+// and disposing the old scope no longer does. If newScope is already
+// disposed the effect stops immediately.
+//
+// Disposing a scope stops the effects it owns, so an effect whose old scope
+// was already disposed can't be revived; Reparent returns ErrEffectStopped
+// for it, as for any stopped effect.
+func (h *EffectHandle) Reparent(newScope *Scope) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.stopped || h.e.stopped.Load() {
+		return ErrEffectStopped
+	}
+	h.scope.removeCleanup(h)
+	h.scope = newScope
+	if !newScope.isLive.Load() {
+		h.stopLocked()
+		return nil
+	}
+	newScope.addCleanup(cleanupEntry{fn: h.Stop, key: h, halt: h.e.halt})
+	return nil
+}
+
+// EffectAfter registers an effect that ignores the first n-1 changes to its
+// dependencies and re-runs on the n-th change and every change after it.
+// Dependencies are discovered by running fn, so it still runs once on
+// creation; while changes are being skipped fn is not re-run and the
+// dependencies from that first run are kept. The effect stops when s is
+// disposed.
+func EffectAfter(s *Scope, n int, fn func()) (stop func()) {
+	if fn == nil {
+		panic(ErrNilEffectFunc)
+	}
+	var changes atomic.Int64
+	e := &effect{fn: fn, scope: s, id: s.engine.nextEffectID.Add(1)}
+	e.skip = func() bool {
+		return changes.Add(1) < int64(n)
+	}
+	e.firstRun()
+	s.addCleanup(cleanupEntry{fn: e.stop, halt: e.halt})
+	return e.stop
+}
+
+// Untrack prevents a signal read from creating a dependency. The listener
+// is restored even if fn panics.
 func Untrack(s *Scope, fn func()) {
-	s.engine.pushListener(nil)
-	defer s.engine.popListener()
-	fn()
+	s.engine.withListener(nil, fn)
 }
 
 // OnCleanup registers a function to be run when the current scope is disposed.
+//
+// Called from the body of an effect running on s, fn belongs to that run
+// instead: it is called just before the effect next re-runs, or when the
+// effect is stopped, so each run can tear down what it set up. Such
+// cleanups run in reverse registration order. Signals, memos, effects and
+// the other values this package creates always tie their own teardown to the
+// scope, wherever they are created.
 func OnCleanup(s *Scope, fn func()) {
-	s.cleanup = append(s.cleanup, fn)
+	if e, ok := s.engine.currentListener().(*effect); ok && e.scope == s {
+		e.mu.Lock()
+		e.runCleanups = append(e.runCleanups, fn)
+		e.mu.Unlock()
+		return
+	}
+	onScopeCleanup(s, fn)
+}
+
+// onScopeCleanup registers fn to run when s is disposed, even when called
+// from an effect body. The constructors in this package use it, so what they
+// create lives as long as s rather than the effect run that created it.
+func onScopeCleanup(s *Scope, fn func()) {
+	s.addCleanup(cleanupEntry{fn: fn})
+}
+
+// OnCleanupPriority registers a function to be run when the scope is
+// disposed, ordered by priority: higher priorities run first, and cleanups
+// of equal priority run in reverse registration order. OnCleanup registers
+// with priority 0. Unlike OnCleanup, it always registers on the scope, even
+// from an effect body.
+func OnCleanupPriority(s *Scope, fn func(), priority int) {
+	s.addCleanup(cleanupEntry{fn: fn, priority: priority})
 }
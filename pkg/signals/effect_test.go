@@ -1,6 +1,12 @@
 package signals
 
-import "testing"
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
 
 func TestEffect_RunsOnSignalChanges(t *testing.T) {
 	eng := Start()
@@ -24,6 +30,50 @@ func TestEffect_RunsOnSignalChanges(t *testing.T) {
 	}
 }
 
+func TestEffect_RunsOnSignalUpdate(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 10)
+	runCount := 0
+	Effect(s, func() {
+		_ = count.Get()
+		runCount++
+	})
+
+	count.Update(func(v *int) { *v++ })
+	if runCount != 2 {
+		t.Errorf("Expected effect to run again after Update, ran %d times", runCount)
+	}
+	if got := count.Get(); got != 11 {
+		t.Errorf("Expected 11, got %d", got)
+	}
+}
+
+func TestEffect_RunsOnceForUpdatesInBatch(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 0)
+	var seen []int
+	Effect(s, func() {
+		seen = append(seen, count.Get())
+	})
+
+	s.Batch(func() {
+		count.Update(func(v *int) { *v++ })
+		count.Update(func(v *int) { *v++ })
+		if len(seen) != 1 {
+			t.Errorf("Expected Update to wait for the batch to end, saw %v", seen)
+		}
+	})
+	if want := []int{0, 2}; !slices.Equal(seen, want) {
+		t.Errorf("Expected one re-run after the batch, saw %v", seen)
+	}
+}
+
 func TestEffect_OnlyRunsOnDependentSignalChanges(t *testing.T) {
 	eng := Start()
 	defer eng.Close()
@@ -151,3 +201,587 @@ func TestEffect_ReturnedCleanupStopsEffect(t *testing.T) {
 		t.Errorf("Expected effect to be stopped, but it ran again. Total runs: %d", runCount)
 	}
 }
+
+func TestEffect_CreatedInBatchRunsImmediatelyThenOnceAtFlush(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 1)
+	var seen []int
+
+	s.Batch(func() {
+		count.Set(2)
+		Effect(s, func() {
+			seen = append(seen, count.Get())
+		})
+
+		if len(seen) != 1 || seen[0] != 2 {
+			t.Fatalf("Expected initial run to observe the mid-batch value 2, got %v", seen)
+		}
+
+		count.Set(3)
+		count.Set(4)
+		if len(seen) != 1 {
+			t.Fatalf("Expected no re-run before the batch flushes, got %v", seen)
+		}
+	})
+
+	if len(seen) != 2 || seen[1] != 4 {
+		t.Errorf("Expected exactly one coalesced re-run observing 4, got %v", seen)
+	}
+}
+
+func TestEffect_CreatedInBatchAfterLastWriteDoesNotRerun(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 1)
+	runCount := 0
+
+	s.Batch(func() {
+		count.Set(2)
+		Effect(s, func() {
+			_ = count.Get()
+			runCount++
+		})
+	})
+
+	if runCount != 1 {
+		t.Errorf("Expected effect to run only its initial pass, ran %d times", runCount)
+	}
+}
+
+func TestEffect_BatchEndsAfterFlush(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 1)
+	runCount := 0
+	Effect(s, func() {
+		_ = count.Get()
+		runCount++
+	})
+
+	s.Batch(func() {
+		count.Set(2)
+	})
+	count.Set(3)
+
+	if runCount != 3 {
+		t.Errorf("Expected writes after a batch to notify immediately, ran %d times", runCount)
+	}
+}
+
+func TestEffectAfter_FirstRerunsOnNthChange(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 0)
+	var seen []int
+	EffectAfter(s, 3, func() {
+		seen = append(seen, count.Get())
+	})
+
+	if len(seen) != 1 {
+		t.Fatalf("Expected one tracking run on creation, got %v", seen)
+	}
+
+	count.Set(1)
+	count.Set(2)
+	if len(seen) != 1 {
+		t.Fatalf("Expected the first two changes to be ignored, got %v", seen)
+	}
+
+	count.Set(3)
+	if len(seen) != 2 || seen[1] != 3 {
+		t.Fatalf("Expected a re-run on the third change observing 3, got %v", seen)
+	}
+
+	count.Set(4)
+	if len(seen) != 3 || seen[2] != 4 {
+		t.Errorf("Expected a re-run on every change after the third, got %v", seen)
+	}
+}
+
+func TestUntrack_PanicLeavesListenerStackBalanced(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	a := New(s, 1)
+	b := New(s, 2)
+	Effect(s, func() {
+		if a.Get() < 0 {
+			Untrack(s, func() {
+				panic("boom")
+			})
+		}
+	})
+
+	if got := capturePanic(func() { a.Set(-1) }); got != "boom" {
+		t.Fatalf("Expected the panic to reach the caller, got %v", got)
+	}
+	if eng.currentListener() != nil || eng.ListenerDepth() != 0 {
+		t.Fatalf("Expected an empty listener stack after the panic, got depth %d", eng.ListenerDepth())
+	}
+
+	// Tracking in an outer computation must survive a panic recovered inside it.
+	runCount := 0
+	Effect(s, func() {
+		_ = capturePanic(func() {
+			Untrack(s, func() {
+				panic("inner")
+			})
+		})
+		_ = b.Get()
+		runCount++
+	})
+
+	b.Set(3)
+	if runCount != 2 {
+		t.Errorf("Expected reads after a recovered Untrack panic to be tracked, ran %d times", runCount)
+	}
+}
+
+func TestEffect_RunOnCreateTrueIsDefault(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 0)
+	runCount := 0
+	Effect(s, func() {
+		_ = count.Get()
+		runCount++
+	}, RunOnCreate(true), Name("counter"))
+
+	if runCount != 1 {
+		t.Fatalf("Expected effect to run on creation, ran %d times", runCount)
+	}
+	count.Set(1)
+	if runCount != 2 {
+		t.Errorf("Expected effect to re-run on change, ran %d times", runCount)
+	}
+}
+
+func TestEffect_RunOnCreateFalseDefersToBatch(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 0)
+	var seen []int
+	s.Batch(func() {
+		Effect(s, func() {
+			seen = append(seen, count.Get())
+		}, RunOnCreate(false))
+
+		if len(seen) != 0 {
+			t.Fatalf("Expected no run before the batch flushes, got %v", seen)
+		}
+		count.Set(1)
+	})
+
+	if len(seen) != 1 || seen[0] != 1 {
+		t.Fatalf("Expected a single deferred run observing 1, got %v", seen)
+	}
+
+	count.Set(2)
+	if len(seen) != 2 || seen[1] != 2 {
+		t.Errorf("Expected the deferred run to have collected dependencies, got %v", seen)
+	}
+}
+
+func TestEffect_RunOnCreateFalseDefersToDispatcher(t *testing.T) {
+	var queue []func()
+	eng := Start(WithDispatchGoroutine(func(fn func()) {
+		queue = append(queue, fn)
+	}))
+	defer eng.Close()
+	s := eng.Scope()
+
+	runCount := 0
+	Effect(s, func() {
+		runCount++
+	}, RunOnCreate(false))
+
+	if runCount != 0 || len(queue) != 1 {
+		t.Fatalf("Expected the initial run to be dispatched, ran %d times with %d queued", runCount, len(queue))
+	}
+	queue[0]()
+	if runCount != 1 {
+		t.Errorf("Expected the dispatched initial run to execute, ran %d times", runCount)
+	}
+}
+
+func TestEffect_NilFunctionPanicsWithClearError(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	if got := capturePanic(func() { Effect(s, nil) }); got != ErrNilEffectFunc {
+		t.Errorf("Expected Effect to panic with ErrNilEffectFunc, got %v", got)
+	}
+	if got := capturePanic(func() { EffectAfter(s, 2, nil) }); got != ErrNilEffectFunc {
+		t.Errorf("Expected EffectAfter to panic with ErrNilEffectFunc, got %v", got)
+	}
+}
+
+func TestEffectHandle_ReparentMovesLifetime(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	oldScope := eng.Scope().Child()
+	newScope := eng.Scope().Child()
+
+	count := New(eng.Scope(), 0)
+	runs := 0
+	h := EffectWithHandle(oldScope, func() {
+		_ = count.Get()
+		runs++
+	})
+
+	if err := h.Reparent(newScope); err != nil {
+		t.Fatalf("Reparent returned an error: %v", err)
+	}
+	oldScope.Dispose()
+	count.Set(1)
+	if runs != 2 {
+		t.Errorf("Expected disposing the old scope to leave the effect running, ran %d times", runs)
+	}
+
+	newScope.Dispose()
+	count.Set(2)
+	if runs != 2 {
+		t.Errorf("Expected disposing the new scope to stop the effect, ran %d times", runs)
+	}
+}
+
+func TestEffectHandle_StopDropsDispatchedRun(t *testing.T) {
+	var queue []func()
+	eng := Start(WithDispatchGoroutine(func(fn func()) {
+		queue = append(queue, fn)
+	}))
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 0)
+	runs := 0
+	h := EffectWithHandle(s, func() {
+		_ = count.Get()
+		runs++
+	})
+
+	count.Set(1)
+	h.Stop()
+	for len(queue) > 0 {
+		fn := queue[0]
+		queue = queue[1:]
+		fn()
+	}
+	count.Set(2)
+	if runs != 1 || len(queue) != 0 {
+		t.Errorf("Expected the queued run to be dropped after Stop, ran %d times with %d queued", runs, len(queue))
+	}
+}
+
+func TestEffectHandle_StopConcurrentWithDispose(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope().Child()
+
+	handles := make([]*EffectHandle, 64)
+	for i := range handles {
+		handles[i] = EffectWithHandle(s, func() {})
+	}
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for _, h := range handles {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			h.Stop()
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		<-start
+		s.Dispose()
+	}()
+	close(start)
+	wg.Wait()
+}
+
+func TestEffectHandle_ReparentAfterDisposalFails(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	oldScope := eng.Scope().Child()
+
+	count := New(eng.Scope(), 0)
+	runs := 0
+	h := EffectWithHandle(oldScope, func() {
+		_ = count.Get()
+		runs++
+	})
+	oldScope.Dispose()
+
+	if err := h.Reparent(eng.Scope()); err != ErrEffectStopped {
+		t.Errorf("Expected ErrEffectStopped, got %v", err)
+	}
+	count.Set(1)
+	if runs != 1 {
+		t.Errorf("Expected the stopped effect to stay stopped, ran %d times", runs)
+	}
+}
+
+func TestLayoutEffect_RunsBeforePassiveEffects(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	width := New(s, 0)
+	doubled := Memo(s, func() int { return width.Get() * 2 })
+	var order []string
+	for range 3 {
+		Effect(s, func() {
+			_ = width.Get()
+			order = append(order, "passive")
+		})
+		LayoutEffect(s, func() {
+			_ = doubled.Get()
+			order = append(order, "layout")
+		})
+	}
+
+	for _, change := range []func(){
+		func() { width.Set(1) },
+		func() { s.Batch(func() { width.Set(2) }) },
+	} {
+		order = nil
+		change()
+		want := []string{"layout", "layout", "layout", "passive", "passive", "passive"}
+		if !slices.Equal(order, want) {
+			t.Errorf("Expected every layout effect before any passive one, got %v", order)
+		}
+	}
+}
+
+func TestLayoutEffect_PassiveEffectSeesLayoutWrites(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	width := New(s, 10)
+	measured := New(s, 0)
+	var seen []int
+	Effect(s, func() {
+		_ = width.Get()
+		seen = append(seen, measured.Get())
+	})
+	LayoutEffect(s, func() {
+		measured.Set(width.Get() + 1)
+	})
+
+	seen = nil
+	width.Set(20)
+	if want := []int{21}; !slices.Equal(seen, want) {
+		t.Errorf("Expected the passive effect to run once with the measured value, got %v", seen)
+	}
+}
+
+func TestEffectHandle_IDsAreUniqueAndFollowCreationOrder(t *testing.T) {
+	ids := func() []uint64 {
+		eng := Start()
+		defer eng.Close()
+		s := eng.Scope()
+
+		var out []uint64
+		for range 3 {
+			h := EffectWithHandle(s, func() {})
+			out = append(out, h.ID())
+		}
+		Effect(s, func() {})
+		out = append(out, EffectWithHandle(s, func() {}).ID())
+		return out
+	}
+
+	first := ids()
+	if want := []uint64{1, 2, 3, 5}; !slices.Equal(first, want) {
+		t.Errorf("Expected IDs %v, got %v", want, first)
+	}
+	if second := ids(); !slices.Equal(first, second) {
+		t.Errorf("Expected the same IDs on a second engine, got %v then %v", first, second)
+	}
+}
+
+func TestEffectHandle_DonePulsesAfterEachRun(t *testing.T) {
+	runs := make(chan func(), 10)
+	go func() {
+		for fn := range runs {
+			fn()
+		}
+	}()
+	eng := Start(WithDispatchGoroutine(func(fn func()) { runs <- fn }))
+	defer close(runs)
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 0)
+	var seen atomic.Int64
+	h := EffectWithHandle(s, func() {
+		seen.Store(int64(count.Get()))
+	})
+	done := h.Done()
+
+	for i := 1; i <= 2; i++ {
+		count.Set(i)
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatalf("Expected a pulse after the run for %d", i)
+		}
+		if got := seen.Load(); got != int64(i) {
+			t.Errorf("Expected the run to have finished with %d before the pulse, saw %d", i, got)
+		}
+	}
+}
+
+func TestEffectHandle_AfterRunCallsEachRegisteredFunc(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 0)
+	h := EffectWithHandle(s, func() { _ = count.Get() })
+	var calls []string
+	h.AfterRun(func() { calls = append(calls, "a") })
+	h.AfterRun(func() { calls = append(calls, "b") })
+
+	count.Set(1)
+	if want := []string{"a", "b"}; !slices.Equal(calls, want) {
+		t.Errorf("Expected %v after one run, got %v", want, calls)
+	}
+}
+
+func TestEffectHandle_TrackAddsDependencyWithoutRerun(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	auto := New(s, 0)
+	extra := New(s, 0)
+	runs := 0
+	h := EffectWithHandle(s, func() {
+		_ = auto.Get()
+		runs++
+	})
+
+	h.Track(Dep[int](extra))
+	if runs != 1 {
+		t.Fatalf("Expected Track not to re-run the effect, ran %d times", runs)
+	}
+	extra.Set(1)
+	if runs != 2 {
+		t.Fatalf("Expected a tracked dependency to re-run the effect, ran %d times", runs)
+	}
+	extra.Set(2)
+	if runs != 3 {
+		t.Errorf("Expected the tracked dependency to survive a run, ran %d times", runs)
+	}
+
+	h.Untrack(Dep[int](extra))
+	extra.Set(3)
+	if runs != 3 {
+		t.Errorf("Expected no re-run after Untrack, ran %d times", runs)
+	}
+	auto.Set(1)
+	extra.Set(4)
+	if runs != 4 {
+		t.Errorf("Expected the untracked dependency to stay dropped after a run, ran %d times", runs)
+	}
+}
+
+func TestEffect_OnCleanupRunsBeforeEachRerun(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope().Child()
+
+	count := New(s, 0)
+	cleanups := 0
+	var seen []int
+	Effect(s, func() {
+		v := count.Get()
+		OnCleanup(s, func() {
+			cleanups++
+			seen = append(seen, v)
+		})
+	})
+
+	for v := 1; v <= 3; v++ {
+		count.Set(v)
+	}
+	if cleanups != 3 || !slices.Equal(seen, []int{0, 1, 2}) {
+		t.Errorf("Expected each run's cleanup before the next run, got %d cleanups for %v", cleanups, seen)
+	}
+
+	s.Dispose()
+	if cleanups != 4 {
+		t.Errorf("Expected the last run's cleanup on disposal, got %d cleanups", cleanups)
+	}
+}
+
+func TestEffect_ValuesCreatedInBodyOutliveTheRun(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 0)
+	other := New(s, 0)
+	innerRuns := 0
+	Effect(s, func() {
+		if count.Get() == 0 {
+			Effect(s, func() {
+				_ = other.Get()
+				innerRuns++
+			})
+		}
+	})
+
+	count.Set(1)
+	other.Set(1)
+	if innerRuns != 2 {
+		t.Errorf("Expected the inner effect to survive the outer re-run, ran %d times", innerRuns)
+	}
+}
+
+func TestEffect_PanicOnFirstRunStopsEffect(t *testing.T) {
+	eng := Start()
+	s := eng.Scope()
+
+	a := New(s, 0)
+	runs := 0
+	got := capturePanic(func() {
+		Effect(s, func() {
+			_ = a.Get()
+			runs++
+			panic("boom")
+		})
+	})
+	if got != "boom" {
+		t.Fatalf("Expected the panic to reach the caller, got %v", got)
+	}
+
+	eng.Close()
+	a.Set(1)
+	if runs != 1 {
+		t.Errorf("Expected the effect not to run again after the engine closed, ran %d times", runs)
+	}
+	if n := len(Subscribers[int](a)); n != 0 {
+		t.Errorf("Expected the effect to be unsubscribed, got %d subscribers", n)
+	}
+}
@@ -2,6 +2,7 @@ package signals
 
 import (
 	"errors"
+	"fmt"
 	"sync"
 	"sync/atomic"
 )
@@ -9,20 +10,60 @@ import (
 var ErrEngineClosed = errors.New("signals: engine is closed")
 
 type Engine struct {
-	root          *Scope
-	isClosed      atomic.Bool
-	listener      computation
-	listenerStack []computation
-	listenerMu    sync.Mutex
-	isBatching    atomic.Bool
-	batchQueue    map[computation]struct{}
-	batchQueueMu  sync.Mutex
+	root         *Scope
+	isClosed     atomic.Bool
+	listeners    sync.Map // goroutine ID -> *listenerStack
+	batchDepth   int      // guarded by batchQueueMu
+	batchQueue   map[computation]struct{}
+	batchQueueMu sync.Mutex
+	clock        Clock
+	dispatch     func(fn func())
+	strict       bool
+	writeDepth   atomic.Int32   // in-flight writes on all goroutines
+	writes       map[uint64]int // in-flight writes by goroutine ID
+	writesMu     sync.Mutex
+	registry     map[string]namedSignal
+	registryMu   sync.Mutex
+	auditReads   bool
+	scheduled    atomic.Int64
+	nextEffectID atomic.Uint64
+	beforeClose  []func()
+	afterClose   []func()
+	onError      func(error)
+	leakCheck    bool
+	leakProbes   []leakProbe
+	leakMu       sync.Mutex
+
+	// activeListeners counts the computations on every goroutine's stack in
+	// listeners, letting reads skip the goroutine lookup when zero.
+	activeListeners atomic.Int64
+
+	maxSubscribers int
+
+	asyncDispose bool
+	flushBudget  int
+	disposer     disposer
+
+	writeMiddleware []func(next func(name string, v any)) func(name string, v any)
+
+	// phaseDepth counts the propagations in progress. While it is non-zero
+	// passive effects wait in passive for the outermost one to finish.
+	phaseDepth int
+	passive    []*effect
+	microtasks []func()
+	phaseMu    sync.Mutex
+
+	// progress is closed, and cleared, whenever pending work finishes, to
+	// wake WaitIdle callers.
+	progress   chan struct{}
+	progressMu sync.Mutex
 }
 type Option func(*Engine)
 
 func Start(opts ...Option) *Engine {
 	e := &Engine{
 		batchQueue: make(map[computation]struct{}),
+		clock:      realClock{},
 	}
 	e.root = &Scope{
 		isLive: atomic.Bool{},
@@ -35,34 +76,350 @@ func Start(opts ...Option) *Engine {
 	return e
 }
 
+// Close runs the WithBeforeClose hooks, disposes the root scope, then runs
+// the WithOnClose hooks. With WithLeakCheck, leaks are checked for between
+// disposal and the WithOnClose hooks. With WithAsyncDispose, disposals still
+// queued are waited for before the root is disposed. Only the first call does any of this; later calls,
+// including concurrent ones, return ErrEngineClosed without waiting.
 func (e *Engine) Close() error {
 	if e.isClosed.Swap(true) {
 		return ErrEngineClosed
 	}
+	for _, fn := range e.beforeClose {
+		fn()
+	}
+	e.disposer.close()
 	e.root.Dispose()
-	return nil
+	var err error
+	if e.leakCheck {
+		if leak := e.checkLeaks(); leak != nil && !e.reportError(leak) {
+			err = leak
+		}
+	}
+	for _, fn := range e.afterClose {
+		fn()
+	}
+	return err
+}
+
+// WithErrorHandler sets the function that receives errors the engine
+// detects but has no caller to return them to.
+func WithErrorHandler(fn func(error)) Option {
+	return func(e *Engine) {
+		e.onError = fn
+	}
+}
+
+// WithWriteMiddleware adds mw to the chain every signal Set passes through,
+// including Sets made by combinators such as Debounced. Middleware receives
+// the signal's name, or "" if it has none, and the value being written, and
+// decides what to pass to next: the same value, a transformed one, which
+// must have the signal's type, or nothing at all. A write that never reaches
+// the end of the chain is vetoed: the value isn't stored and subscribers
+// aren't notified. Middleware added first sees writes first. Update bypasses
+// the chain.
+func WithWriteMiddleware(mw func(next func(name string, v any)) func(name string, v any)) Option {
+	return func(e *Engine) {
+		e.writeMiddleware = append(e.writeMiddleware, mw)
+	}
+}
+
+// reportError passes err to the error handler, reporting whether there was
+// one.
+func (e *Engine) reportError(err error) bool {
+	if e.onError == nil {
+		return false
+	}
+	e.onError(err)
+	return true
+}
+
+// WithBeforeClose registers fn to run when the engine closes, before the
+// root scope is disposed, so the reactive graph is still live. Hooks run in
+// the order they were registered.
+func WithBeforeClose(fn func()) Option {
+	return func(e *Engine) {
+		e.beforeClose = append(e.beforeClose, fn)
+	}
+}
+
+// WithOnClose registers fn to run when the engine closes, after the root
+// scope and all its cleanups have run, for engine-level teardown such as
+// flushing metrics or closing a logger. Hooks run in the order they were
+// registered. Forks don't inherit close hooks.
+func WithOnClose(fn func()) Option {
+	return func(e *Engine) {
+		e.afterClose = append(e.afterClose, fn)
+	}
 }
 
 func (e *Engine) Scope() *Scope {
 	return e.root
 }
 
-func (e *Engine) pushListener(c computation) {
-	e.listenerMu.Lock()
-	defer e.listenerMu.Unlock()
-	e.listenerStack = append(e.listenerStack, e.listener)
-	e.listener = c
+// WithScope runs fn with a fresh child of the root scope and disposes it
+// when fn returns or panics, tearing down everything fn created in it.
+func (e *Engine) WithScope(fn func(s *Scope)) {
+	s := e.root.Child()
+	defer s.Dispose()
+	fn(s)
+}
+
+// listenerStack is one goroutine's stack of running computations. Only that
+// goroutine touches it, so it needs no lock of its own.
+type listenerStack struct {
+	stack []computation
+}
+
+// listenerStacks recycles the stacks of goroutines that finished running
+// computations.
+var listenerStacks = sync.Pool{New: func() any { return new(listenerStack) }}
+
+// listenerStackOf returns the listener stack of the goroutine id, or nil if
+// it has none.
+func (e *Engine) listenerStackOf(id uint64) *listenerStack {
+	if ls, ok := e.listeners.Load(id); ok {
+		return ls.(*listenerStack)
+	}
+	return nil
+}
+
+// pushListener makes c the calling goroutine's active listener, returning
+// the goroutine's ID for the matching popListener.
+func (e *Engine) pushListener(c computation) (id uint64) {
+	id = goroutineID()
+	ls := e.listenerStackOf(id)
+	if ls == nil {
+		ls = listenerStacks.Get().(*listenerStack)
+		e.listeners.Store(id, ls)
+	}
+	ls.stack = append(ls.stack, c)
+	e.activeListeners.Add(1)
+	return id
+}
+
+// notifyAll notifies subs of a change, or queues them if a batch is open.
+// Callers must not hold any signal lock, so subscribers are free to read and
+// write signals, including the one that changed.
+func (e *Engine) notifyAll(subs []computation) {
+	e.batchQueueMu.Lock()
+	if e.batchDepth > 0 {
+		for _, sub := range subs {
+			e.batchQueue[sub] = struct{}{}
+		}
+		e.batchQueueMu.Unlock()
+		return
+	}
+	e.batchQueueMu.Unlock()
+
+	e.propagate(subs)
+}
+
+// propagate notifies subs in two phases: layout effects reached by the
+// change run as they are notified, and passive effects run once the
+// outermost propagation has notified everything. Propagations running
+// concurrently on other goroutines share the passive phase, so it runs when
+// the last of them finishes.
+//
+// A subscriber that panics doesn't stop the others from being notified: the
+// panic is recovered and passed to the error handler as an
+// ErrSubscriberPanic. Without a handler, the first such panic is re-raised
+// once the propagation has finished.
+func (e *Engine) propagate(subs []computation) {
+	var unhandled any
+	guard := func(fn func()) {
+		if r := e.runGuarded(fn); r != nil && unhandled == nil {
+			unhandled = r
+		}
+	}
+	defer func() {
+		if unhandled != nil {
+			panic(unhandled)
+		}
+	}()
+
+	e.phaseMu.Lock()
+	e.phaseDepth++
+	e.phaseMu.Unlock()
+
+	outermost := false
+	func() {
+		defer func() {
+			e.phaseMu.Lock()
+			e.phaseDepth--
+			outermost = e.phaseDepth == 0
+			e.phaseMu.Unlock()
+		}()
+		for _, sub := range subs {
+			guard(sub.notify)
+		}
+	}()
+	if !outermost {
+		return
+	}
+
+	// Microtasks run once the passive phase is over, and may start more
+	// passive work of their own.
+	for {
+		e.phaseMu.Lock()
+		passive := e.passive
+		e.passive = nil
+		var micro []func()
+		if len(passive) == 0 {
+			micro = e.microtasks
+			e.microtasks = nil
+		}
+		e.phaseMu.Unlock()
+		if len(passive) == 0 && len(micro) == 0 {
+			return
+		}
+		for _, eff := range passive {
+			eff.deferred.Store(false)
+		}
+		if e.flushBudget > 0 && e.dispatch != nil {
+			e.scheduleBudgeted(passive)
+		} else {
+			for _, eff := range passive {
+				guard(eff.schedule)
+			}
+		}
+		for _, fn := range micro {
+			guard(fn)
+		}
+	}
+}
+
+// ErrSubscriberPanic wraps a panic recovered while notifying a subscriber.
+// If the panic value was an error, it is wrapped too.
+var ErrSubscriberPanic = errors.New("signals: subscriber panicked")
+
+// runGuarded runs fn, recovering a panic and passing it to the error
+// handler. A panic with no handler to take it is returned instead.
+func (e *Engine) runGuarded(fn func()) (unhandled any) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+		var err error
+		if cause, ok := r.(error); ok {
+			err = fmt.Errorf("%w: %w", ErrSubscriberPanic, cause)
+		} else {
+			err = fmt.Errorf("%w: %v", ErrSubscriberPanic, r)
+		}
+		if !e.reportError(err) {
+			unhandled = r
+		}
+	}()
+	fn()
+	return nil
+}
+
+// queueMicrotask runs fn once the propagation in progress, including its
+// passive phase, has finished, or immediately if there is none.
+func (e *Engine) queueMicrotask(fn func()) {
+	e.phaseMu.Lock()
+	if e.phaseDepth > 0 {
+		e.microtasks = append(e.microtasks, fn)
+		e.phaseMu.Unlock()
+		return
+	}
+	e.phaseMu.Unlock()
+	fn()
+}
+
+// deferPassive queues eff for the passive phase if a propagation is in
+// progress, reporting whether it did.
+func (e *Engine) deferPassive(eff *effect) bool {
+	e.phaseMu.Lock()
+	defer e.phaseMu.Unlock()
+	if e.phaseDepth == 0 {
+		return false
+	}
+	if !eff.deferred.Swap(true) {
+		e.passive = append(e.passive, eff)
+	}
+	return true
+}
+
+// currentListener returns the computation that reads on the calling
+// goroutine should subscribe, or nil outside of any computation.
+func (e *Engine) currentListener() computation {
+	// Finding the goroutine is costly; skip it when nothing is running.
+	if e.activeListeners.Load() == 0 {
+		return nil
+	}
+	if ls := e.listenerStackOf(goroutineID()); ls != nil && len(ls.stack) > 0 {
+		return ls.stack[len(ls.stack)-1]
+	}
+	return nil
+}
+
+// ListenerDepth returns how many computations are currently being tracked
+// on the calling goroutine, which is zero whenever no effect or memo is
+// running on it. It is meant for asserting the listener stack is balanced
+// in tests.
+func (e *Engine) ListenerDepth() int {
+	if e.activeListeners.Load() == 0 {
+		return 0
+	}
+	if ls := e.listenerStackOf(goroutineID()); ls != nil {
+		return len(ls.stack)
+	}
+	return 0
 }
 
-func (e *Engine) popListener() {
-	e.listenerMu.Lock()
-	defer e.listenerMu.Unlock()
-	if len(e.listenerStack) > 0 {
-		e.listenerStack = e.listenerStack[:len(e.listenerStack)-1]
+// popListener restores the listener that was active on the goroutine id
+// before the last pushListener there.
+func (e *Engine) popListener(id uint64) {
+	ls := e.listenerStackOf(id)
+	if ls == nil || len(ls.stack) == 0 {
+		return
 	}
-	if len(e.listenerStack) > 0 {
-		e.listener = e.listenerStack[len(e.listenerStack)-1]
-	} else {
-		e.listener = nil
+	n := len(ls.stack) - 1
+	ls.stack[n] = nil
+	ls.stack = ls.stack[:n]
+	if n == 0 {
+		// Drop the stack so goroutines that are done leave nothing behind.
+		e.listeners.Delete(id)
+		listenerStacks.Put(ls)
 	}
+	e.activeListeners.Add(-1)
+}
+
+// withListener runs fn with c as the calling goroutine's active listener,
+// so reads inside fn subscribe c as they would from a running effect or memo.
+// A nil c makes reads untracked. The previous listener is restored even if fn
+// panics.
+func (e *Engine) withListener(c computation, fn func()) {
+	id := e.pushListener(c)
+	defer e.popListener(id)
+	fn()
+}
+
+// WithDispatchGoroutine marshals every effect re-run onto a single consumer
+// goroutine, such as a UI loop. Instead of re-running an effect inline when a
+// dependency changes, the engine passes the run to send, which must queue it
+// for execution on the consumer's loop in the order received. Notifications
+// that arrive while an effect's run is still queued are coalesced into that
+// run.
+//
+// An effect's initial run still happens synchronously inside Effect.
+func WithDispatchGoroutine(send func(fn func())) Option {
+	return func(e *Engine) {
+		e.dispatch = send
+	}
+}
+
+// A Scheduler decides when effect re-runs happen. Schedule must eventually
+// call fn, and must call the functions it is given in the order received.
+type Scheduler interface {
+	Schedule(fn func())
+}
+
+// WithScheduler hands every effect re-run to sched instead of running it
+// inline, with the same coalescing as WithDispatchGoroutine, which is
+// equivalent to a Scheduler whose Schedule sends fn to the consumer's loop.
+func WithScheduler(sched Scheduler) Option {
+	return WithDispatchGoroutine(sched.Schedule)
 }
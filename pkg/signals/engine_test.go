@@ -1,6 +1,11 @@
 package signals
 
-import "testing"
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+)
 
 func TestEngine_StartAndClose(t *testing.T) {
 	// This test fails until you create the Start function
@@ -67,3 +72,242 @@ func TestEngine_CloseIsIdempotent(t *testing.T) {
 		t.Error("second Close() did not return an error, but it should have")
 	}
 }
+
+func TestEngine_WithDispatchGoroutineRunsEffectsOnLoop(t *testing.T) {
+	var queue []func()
+	eng := Start(WithDispatchGoroutine(func(fn func()) {
+		queue = append(queue, fn)
+	}))
+	defer eng.Close()
+	s := eng.Scope()
+
+	a := New(s, 0)
+	b := New(s, 0)
+	var order []string
+	Effect(s, func() {
+		_ = a.Get()
+		order = append(order, "a")
+	})
+	Effect(s, func() {
+		_ = b.Get()
+		order = append(order, "b")
+	})
+	order = nil
+
+	b.Set(1)
+	a.Set(1)
+	a.Set(2)
+	if len(order) != 0 {
+		t.Fatalf("Expected no effect to run before the loop processes it, got %v", order)
+	}
+	if len(queue) != 2 {
+		t.Fatalf("Expected two queued runs with the repeated write coalesced, got %d", len(queue))
+	}
+
+	for len(queue) > 0 {
+		fn := queue[0]
+		queue = queue[1:]
+		fn()
+	}
+	if len(order) != 2 || order[0] != "b" || order[1] != "a" {
+		t.Errorf("Expected effects to run in dispatch order [b a], got %v", order)
+	}
+}
+
+func TestEngine_WithDispatchGoroutineAcrossGoroutines(t *testing.T) {
+	loop := make(chan func(), 16)
+	eng := Start(WithDispatchGoroutine(func(fn func()) {
+		loop <- fn
+	}))
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 0)
+	seen := make(chan int, 16)
+	Effect(s, func() {
+		seen <- count.Get()
+	})
+	<-seen
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		count.Set(42)
+	}()
+	<-done
+
+	(<-loop)()
+	if val := <-seen; val != 42 {
+		t.Errorf("Expected effect run on the loop to observe 42, got %d", val)
+	}
+}
+
+func TestEngine_CloseHooksRunOnceAroundDisposal(t *testing.T) {
+	var mu sync.Mutex
+	var order []string
+	record := func(step string) func() {
+		return func() {
+			mu.Lock()
+			defer mu.Unlock()
+			order = append(order, step)
+		}
+	}
+	eng := Start(
+		WithOnClose(record("after 1")),
+		WithBeforeClose(record("before 1")),
+		WithOnClose(record("after 2")),
+		WithBeforeClose(record("before 2")),
+	)
+	OnCleanup(eng.Scope(), record("cleanup"))
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			eng.Close()
+		}()
+	}
+	wg.Wait()
+
+	want := []string{"before 1", "before 2", "cleanup", "after 1", "after 2"}
+	if !slices.Equal(order, want) {
+		t.Errorf("Expected %v, got %v", want, order)
+	}
+}
+
+func TestEngine_WithScopeDisposesOnReturn(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+
+	count := New(eng.Scope(), 0)
+	runs := 0
+	eng.WithScope(func(s *Scope) {
+		Effect(s, func() {
+			_ = count.Get()
+			runs++
+		})
+		count.Set(1)
+	})
+	count.Set(2)
+
+	if runs != 2 {
+		t.Errorf("Expected the effect to stop when WithScope returned, ran %d times", runs)
+	}
+	if n := len(eng.Scope().cleanup); n != 0 {
+		t.Errorf("Expected the disposed scope to leave no cleanup on the root, found %d", n)
+	}
+}
+
+func TestEngine_PanickingSubscriberDoesNotStopFlush(t *testing.T) {
+	var errs []error
+	eng := Start(WithErrorHandler(func(err error) {
+		errs = append(errs, err)
+	}))
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 0)
+	ran := map[string]int{}
+	for _, name := range []string{"a", "bad", "c"} {
+		Effect(s, func() {
+			if count.Get() > 0 && name == "bad" {
+				panic("boom")
+			}
+			ran[name]++
+		})
+	}
+
+	s.Batch(func() {
+		count.Set(1)
+	})
+	if ran["a"] != 2 || ran["c"] != 2 {
+		t.Errorf("Expected the other effects to re-run despite the panic, got %v", ran)
+	}
+	if len(errs) != 1 || !errors.Is(errs[0], ErrSubscriberPanic) {
+		t.Errorf("Expected one ErrSubscriberPanic to be reported, got %v", errs)
+	}
+
+	count.Set(2)
+	if ran["a"] != 3 || ran["c"] != 3 {
+		t.Errorf("Expected the engine to keep propagating afterwards, got %v", ran)
+	}
+}
+
+func TestEngine_PanickingSubscriberOfMemoDoesNotStopSiblings(t *testing.T) {
+	var errs []error
+	eng := Start(WithErrorHandler(func(err error) {
+		errs = append(errs, err)
+	}))
+	defer eng.Close()
+	s := eng.Scope()
+
+	a := New(s, 0)
+	doubled := Memo(s, func() int { return a.Get() * 2 })
+	seen := make([]int, 3)
+	for i := range seen {
+		LayoutEffect(s, func() {
+			v := doubled.Get()
+			if v > 0 && i == 1 {
+				panic("boom")
+			}
+			seen[i] = v
+		})
+	}
+
+	a.Set(1)
+	if seen[0] != 2 || seen[2] != 2 {
+		t.Errorf("Expected the other effects to see 2 despite the panic, got %v", seen)
+	}
+	if len(errs) != 1 || !errors.Is(errs[0], ErrSubscriberPanic) {
+		t.Errorf("Expected one ErrSubscriberPanic to be reported, got %v", errs)
+	}
+}
+
+func TestEngine_PanickingDispatchedEffectIsReported(t *testing.T) {
+	var queue []func()
+	var errs []error
+	eng := Start(
+		WithDispatchGoroutine(func(fn func()) { queue = append(queue, fn) }),
+		WithErrorHandler(func(err error) { errs = append(errs, err) }),
+	)
+	defer eng.Close()
+	s := eng.Scope()
+	flush := func() {
+		for len(queue) > 0 {
+			fn := queue[0]
+			queue = queue[1:]
+			fn()
+		}
+	}
+
+	count := New(s, 0)
+	other := 0
+	Effect(s, func() {
+		if count.Get() == 1 {
+			panic(errors.New("boom"))
+		}
+	})
+	Effect(s, func() {
+		_ = count.Get()
+		other++
+	})
+
+	count.Set(1)
+	flush()
+	if len(errs) != 1 || !errors.Is(errs[0], ErrSubscriberPanic) {
+		t.Fatalf("Expected one ErrSubscriberPanic to be reported, got %v", errs)
+	}
+	if d := eng.ListenerDepth(); d != 0 {
+		t.Errorf("Expected the listener stack to be unwound, got depth %d", d)
+	}
+
+	count.Set(2)
+	flush()
+	if other != 3 {
+		t.Errorf("Expected the other effect to keep running, ran %d times", other)
+	}
+	if len(errs) != 1 {
+		t.Errorf("Expected the handler to be called once, got %d calls", len(errs))
+	}
+}
@@ -0,0 +1,57 @@
+package signals
+
+import "errors"
+
+// ErrorSignalOption configures ErrorSignal.
+type ErrorSignalOption func(*errorSignalConfig)
+
+type errorSignalConfig struct {
+	accumulate bool
+}
+
+// AccumulateErrors makes ErrorSignal hold every error received so far,
+// joined with errors.Join, instead of only the latest one.
+func AccumulateErrors() ErrorSignalOption {
+	return func(c *errorSignalConfig) {
+		c.accumulate = true
+	}
+}
+
+// ErrorSignal returns a signal fed by errs from a goroutine of its own. It
+// holds nil until the first error arrives, then the latest error, or with
+// AccumulateErrors all of them joined. Receiving nil clears it back to nil in
+// either mode, so a worker can report that it has recovered. The goroutine
+// exits when errs is closed, keeping the last value, or when s is disposed.
+func ErrorSignal(s *Scope, errs <-chan error, opts ...ErrorSignalOption) Readonly[error] {
+	var cfg errorSignalConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	out := New[error](s, nil)
+	done := make(chan struct{})
+	onScopeCleanup(s, func() { close(done) })
+	go func() {
+		var acc error
+		for {
+			select {
+			case <-done:
+				return
+			case err, ok := <-errs:
+				if !ok {
+					return
+				}
+				switch {
+				case err == nil:
+					acc = nil
+				case cfg.accumulate:
+					acc = errors.Join(acc, err)
+				default:
+					acc = err
+				}
+				out.Set(acc)
+			}
+		}
+	}()
+	return out
+}
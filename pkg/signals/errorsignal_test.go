@@ -0,0 +1,57 @@
+package signals
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// waitFor polls get until it returns want or a second passes.
+func waitFor[T comparable](t *testing.T, get func() T, want T) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for get() != want {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected %v, still %v", want, get())
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestErrorSignal_HoldsLatestError(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	errs := make(chan error)
+	latest := ErrorSignal(s, errs)
+	if err := latest.Get(); err != nil {
+		t.Fatalf("Expected nil before any error, got %v", err)
+	}
+
+	first, second := errors.New("first"), errors.New("second")
+	errs <- first
+	waitFor(t, latest.Get, first)
+	errs <- second
+	waitFor(t, latest.Get, second)
+	errs <- nil
+	waitFor(t, latest.Get, nil)
+}
+
+func TestErrorSignal_AccumulatesErrors(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	errs := make(chan error)
+	all := ErrorSignal(s, errs, AccumulateErrors())
+	first, second := errors.New("first"), errors.New("second")
+	errs <- first
+	errs <- second
+	close(errs)
+
+	waitFor(t, func() bool {
+		err := all.Get()
+		return errors.Is(err, first) && errors.Is(err, second)
+	}, true)
+}
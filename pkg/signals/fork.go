@@ -0,0 +1,35 @@
+package signals
+
+// Fork creates an independent engine seeded with copies of the current
+// values of e's named signals, for speculative or what-if computation. The
+// fork shares e's configuration but nothing else: changes on either side are
+// never visible to the other.
+//
+// Only named signal values are copied, and only shallowly. Effects, memos,
+// unnamed signals and the rest of the derived graph are not carried over;
+// retrieve the copies with LookupNamed and rebuild any derivations on the fork.
+func (e *Engine) Fork() *Engine {
+	f := Start()
+	f.clock = e.clock
+	f.dispatch = e.dispatch
+	f.strict = e.strict
+	f.auditReads = e.auditReads
+	f.onError = e.onError
+	f.leakCheck = e.leakCheck
+	f.writeMiddleware = e.writeMiddleware
+	f.maxSubscribers = e.maxSubscribers
+	f.asyncDispose = e.asyncDispose
+	f.flushBudget = e.flushBudget
+
+	e.registryMu.Lock()
+	named := make([]namedSignal, 0, len(e.registry))
+	for _, sig := range e.registry {
+		named = append(named, sig)
+	}
+	e.registryMu.Unlock()
+
+	for _, sig := range named {
+		sig.cloneInto(f.root)
+	}
+	return f
+}
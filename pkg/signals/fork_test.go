@@ -0,0 +1,67 @@
+package signals
+
+import "testing"
+
+func TestEngine_ForkCopiesNamedValues(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	balance := NewNamed(s, "balance", 100)
+	balance.Set(150)
+
+	fork := eng.Fork()
+	defer fork.Close()
+
+	forked, ok := LookupNamed[int](fork, "balance")
+	if !ok {
+		t.Fatal("Expected fork to contain the named signal")
+	}
+	if val := forked.Get(); val != 150 {
+		t.Errorf("Expected forked value to be 150, got %d", val)
+	}
+}
+
+func TestEngine_ForkIsIndependent(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	balance := NewNamed(s, "balance", 100)
+	originalRuns := 0
+	Effect(s, func() {
+		_ = balance.Get()
+		originalRuns++
+	})
+
+	fork := eng.Fork()
+	defer fork.Close()
+	forked, _ := LookupNamed[int](fork, "balance")
+
+	forked.Set(0)
+	if val := balance.Get(); val != 100 {
+		t.Errorf("Expected original to be unaffected by the fork, got %d", val)
+	}
+	if originalRuns != 1 {
+		t.Errorf("Expected original effects not to run for fork writes, ran %d times", originalRuns)
+	}
+
+	balance.Set(200)
+	if val := forked.Get(); val != 0 {
+		t.Errorf("Expected fork to be unaffected by the original, got %d", val)
+	}
+}
+
+func TestLookup_RejectsWrongType(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	_ = NewNamed(s, "name", "alice")
+	if _, ok := LookupNamed[int](eng, "name"); ok {
+		t.Error("Expected LookupNamed with the wrong type to fail")
+	}
+	if _, ok := LookupNamed[string](eng, "missing"); ok {
+		t.Error("Expected LookupNamed of an unknown name to fail")
+	}
+}
@@ -0,0 +1,22 @@
+package signals
+
+// FrozenWhile returns a value that follows src while frozen is false. While
+// frozen is true it keeps the value src had when freezing began and ignores
+// src's changes, which are not replayed: when frozen turns false again it
+// jumps straight to src's current value. Subscribers are only notified when
+// the returned value changes, if T is comparable.
+//
+// FrozenWhile observes its inputs through an effect, so a batch that writes
+// src and then sets frozen freezes on src's value from before the batch.
+func FrozenWhile[T any](s *Scope, src Readonly[T], frozen Readonly[bool]) Readonly[T] {
+	var held T
+	Untrack(s, func() {
+		held = src.Get()
+	})
+	return derived(s, func() T {
+		if !frozen.Get() {
+			held = src.Get()
+		}
+		return held
+	})
+}
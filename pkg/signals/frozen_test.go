@@ -0,0 +1,55 @@
+package signals
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFrozenWhile_HoldsThenResyncs(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := New(s, 1)
+	frozen := New(s, false)
+	out := FrozenWhile(s, src, frozen)
+
+	var seen []int
+	Effect(s, func() {
+		seen = append(seen, out.Get())
+	})
+
+	src.Set(2)
+	frozen.Set(true)
+	src.Set(3)
+	src.Set(4)
+	if got := out.Get(); got != 2 {
+		t.Errorf("Expected the value from when freezing began, got %d", got)
+	}
+	frozen.Set(false)
+	if got := out.Get(); got != 4 {
+		t.Errorf("Expected a resync to the current value, got %d", got)
+	}
+	if want := []int{1, 2, 4}; !slices.Equal(seen, want) {
+		t.Errorf("Expected subscribers to see %v without intermediate values, got %v", want, seen)
+	}
+}
+
+func TestFrozenWhile_StartsFrozen(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := New(s, "a")
+	frozen := New(s, true)
+	out := FrozenWhile(s, src, frozen)
+
+	src.Set("b")
+	if got := out.Get(); got != "a" {
+		t.Errorf("Expected the value at creation while frozen, got %q", got)
+	}
+	frozen.Set(false)
+	if got := out.Get(); got != "b" {
+		t.Errorf("Expected %q after unfreezing, got %q", "b", got)
+	}
+}
@@ -0,0 +1,32 @@
+//go:build !signals_getg || !(amd64 || arm64)
+
+package signals
+
+import (
+	"bytes"
+	"runtime"
+	"strconv"
+)
+
+// goroutineID returns the calling goroutine's ID, parsed from the header of
+// its stack trace ("goroutine 42 [running]:"). Go doesn't expose the ID
+// directly; it is only used to keep state per goroutine.
+//
+// This costs a few microseconds per call, more on deep stacks, and is paid
+// by every effect or memo run and every read made inside one. On amd64 and
+// arm64, building with -tags signals_getg swaps in an assembly version that
+// reads the runtime's goroutine pointer instead, at the price of depending on
+// runtime internals.
+func goroutineID() uint64 {
+	var buf [64]byte
+	b := buf[:runtime.Stack(buf[:], false)]
+	b = bytes.TrimPrefix(b, []byte("goroutine "))
+	if i := bytes.IndexByte(b, ' '); i >= 0 {
+		b = b[:i]
+	}
+	id, err := strconv.ParseUint(string(b), 10, 64)
+	if err != nil {
+		panic("signals: cannot parse goroutine ID from " + strconv.Quote(string(buf[:])))
+	}
+	return id
+}
@@ -0,0 +1,18 @@
+//go:build signals_getg && (amd64 || arm64)
+
+package signals
+
+// getg returns the address of the calling goroutine's runtime g struct.
+// It is implemented in assembly, which reads a runtime internal that may
+// change between Go releases, so it is only built with the signals_getg
+// tag.
+func getg() uintptr
+
+// goroutineID returns an identifier for the calling goroutine: the address
+// of its g struct, which stays put for the goroutine's lifetime and is unique
+// among live goroutines. It is only used to keep state per goroutine, which
+// is always dropped before the goroutine can exit, so a g reused by a later
+// goroutine never inherits it.
+func goroutineID() uint64 {
+	return uint64(getg())
+}
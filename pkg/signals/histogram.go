@@ -0,0 +1,43 @@
+package signals
+
+import (
+	"errors"
+	"slices"
+)
+
+// ErrUnsortedBuckets is the panic value raised when Histogram is given bucket
+// bounds that are not strictly increasing.
+var ErrUnsortedBuckets = errors.New("signals: histogram buckets must be strictly increasing")
+
+// Histogram returns a signal holding counts of the values src has taken,
+// starting with its current one. buckets lists strictly increasing upper
+// bounds: a value v is counted in the first bucket with v <= bound, and
+// values above the last bound, or NaN, in an extra overflow bucket, so the
+// counts have len(buckets)+1 entries. Counts are per bucket, not cumulative.
+//
+// Each observation publishes a new slice, so a slice returned by Get is
+// never modified. Histogram observes src through an effect, so a value
+// overwritten within one batch is never counted.
+func Histogram(s *Scope, src Readonly[float64], buckets []float64) Readonly[[]uint64] {
+	for i := 1; i < len(buckets); i++ {
+		if !(buckets[i-1] < buckets[i]) {
+			panic(ErrUnsortedBuckets)
+		}
+	}
+	bounds := slices.Clone(buckets)
+	counts := make([]uint64, len(bounds)+1)
+	out := New(s, slices.Clone(counts))
+	Effect(s, func() {
+		v := src.Get()
+		i := slices.IndexFunc(bounds, func(b float64) bool { return v <= b })
+		if i < 0 {
+			i = len(bounds)
+		}
+		counts[i]++
+		next := slices.Clone(counts)
+		Untrack(s, func() {
+			out.Set(next)
+		})
+	})
+	return out
+}
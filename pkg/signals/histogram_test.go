@@ -0,0 +1,37 @@
+package signals
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestHistogram_CountsValuesPerBucket(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := New(s, 0.5)
+	hist := Histogram(s, src, []float64{1, 5, 10})
+	before := hist.Get()
+
+	for _, v := range []float64{1, 3, 5, 7, 12, 100, -2} {
+		src.Set(v)
+	}
+	if want := []uint64{3, 2, 1, 2}; !slices.Equal(hist.Get(), want) {
+		t.Errorf("Expected counts %v, got %v", want, hist.Get())
+	}
+	if want := []uint64{1, 0, 0, 0}; !slices.Equal(before, want) {
+		t.Errorf("Expected an earlier snapshot to stay %v, got %v", want, before)
+	}
+}
+
+func TestHistogram_RejectsUnsortedBuckets(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := New(s, 0.0)
+	if got := capturePanic(func() { Histogram(s, src, []float64{1, 1}) }); got != ErrUnsortedBuckets {
+		t.Errorf("Expected ErrUnsortedBuckets, got %v", got)
+	}
+}
@@ -0,0 +1,41 @@
+package signals
+
+import "cmp"
+
+// InRangeOption configures InRange.
+type InRangeOption func(*inRangeConfig)
+
+type inRangeConfig struct {
+	openLo, openHi bool
+}
+
+// ExcludeLo makes InRange's lower bound exclusive.
+func ExcludeLo() InRangeOption {
+	return func(c *inRangeConfig) {
+		c.openLo = true
+	}
+}
+
+// ExcludeHi makes InRange's upper bound exclusive.
+func ExcludeHi() InRangeOption {
+	return func(c *inRangeConfig) {
+		c.openHi = true
+	}
+}
+
+// InRange returns a value reporting whether lo <= src <= hi, with either
+// bound made exclusive by ExcludeLo or ExcludeHi. It only notifies its
+// subscribers when the answer flips, not on every change of src. A NaN
+// source is never in range.
+func InRange[T cmp.Ordered](s *Scope, src Readonly[T], lo, hi T, opts ...InRangeOption) Readonly[bool] {
+	var cfg inRangeConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return derived(s, func() bool {
+		v := src.Get()
+		aboveLo := lo < v || (!cfg.openLo && lo == v)
+		belowHi := v < hi || (!cfg.openHi && v == hi)
+		return aboveLo && belowHi
+	})
+}
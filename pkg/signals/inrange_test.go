@@ -0,0 +1,48 @@
+package signals
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestInRange_FlipsAtBoundaries(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := New(s, 0)
+	in := InRange[int](s, src, 1, 3)
+	var seen []bool
+	Effect(s, func() {
+		seen = append(seen, in.Get())
+	})
+
+	for _, v := range []int{1, 2, 3, 4, 5, 3} {
+		src.Set(v)
+	}
+	if want := []bool{false, true, false, true}; !slices.Equal(seen, want) {
+		t.Errorf("Expected notifications only on flips %v, got %v", want, seen)
+	}
+}
+
+func TestInRange_ExclusiveBounds(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := New(s, 1.0)
+	open := InRange[float64](s, src, 1, 3, ExcludeLo(), ExcludeHi())
+	halfOpen := InRange[float64](s, src, 1, 3, ExcludeHi())
+
+	if open.Get() || !halfOpen.Get() {
+		t.Errorf("Expected the lower bound to be excluded only when asked, got %v and %v", open.Get(), halfOpen.Get())
+	}
+	src.Set(3)
+	if open.Get() || halfOpen.Get() {
+		t.Errorf("Expected the excluded upper bound to be out of range, got %v and %v", open.Get(), halfOpen.Get())
+	}
+	src.Set(2)
+	if !open.Get() || !halfOpen.Get() {
+		t.Errorf("Expected an interior value to be in range, got %v and %v", open.Get(), halfOpen.Get())
+	}
+}
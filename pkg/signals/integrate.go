@@ -0,0 +1,47 @@
+package signals
+
+import (
+	"sync"
+	"time"
+)
+
+// Integrate returns a signal holding the time integral of src, in units of
+// src times seconds, accumulated with the trapezoidal rule from creation
+// onwards. A sample is taken whenever src changes and on every tick of a
+// Ticker, and each interval between samples contributes the mean of its
+// endpoint values times its length, so a change part way through a tick is
+// interpolated linearly from the previous sample.
+func Integrate(s *Scope, src Readonly[float64], clock Clock, opts ...TickOption) Readonly[float64] {
+	cfg := newTickConfig(opts)
+	now := Ticker(s, clock, cfg.tick)
+
+	var (
+		mu       sync.Mutex
+		lastAt   time.Time
+		lastV    float64
+		sum      float64
+		out      Signal[float64]
+		hasFirst bool
+	)
+	Effect(s, func() {
+		v := src.Get()
+		_ = now.Get()
+		t := clock.Now()
+
+		mu.Lock()
+		if hasFirst {
+			sum += (lastV + v) / 2 * t.Sub(lastAt).Seconds()
+		}
+		hasFirst = true
+		lastAt, lastV = t, v
+		total := sum
+		mu.Unlock()
+
+		if out == nil {
+			out = New(s, total)
+			return
+		}
+		out.Set(total)
+	})
+	return out
+}
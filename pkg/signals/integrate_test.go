@@ -0,0 +1,41 @@
+package signals_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/edgarvarela24/signals-go/pkg/signals"
+	"github.com/edgarvarela24/signals-go/pkg/signals/signalstest"
+)
+
+func TestIntegrate_AccumulatesTrapezoids(t *testing.T) {
+	clock := signalstest.NewFakeClock(time.Unix(0, 0))
+	eng := signals.Start(signals.WithClock(clock))
+	defer eng.Close()
+	s := eng.Scope()
+
+	rate := signals.New(s, 2.0)
+	total := signals.Integrate(s, rate, clock, signals.TickEvery(time.Second))
+
+	check := func(want float64, when string) {
+		t.Helper()
+		if got := total.Get(); math.Abs(got-want) > 1e-9 {
+			t.Errorf("Expected %v %s, got %v", want, when, got)
+		}
+	}
+	check(0, "at creation")
+
+	clock.Advance(time.Second)
+	check(2, "after 1s at 2") // 2 * 1
+
+	clock.Advance(500 * time.Millisecond)
+	rate.Set(4)
+	check(3.5, "after the change at 1.5s") // + (2+4)/2 * 0.5
+
+	clock.Advance(500 * time.Millisecond)
+	check(5.5, "at the 2s tick") // + 4 * 0.5
+
+	clock.Advance(time.Second)
+	check(9.5, "at the 3s tick") // + 4 * 1
+}
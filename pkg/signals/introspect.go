@@ -0,0 +1,90 @@
+package signals
+
+// Computation is a node of the reactive graph that depends on signals: an
+// effect or a memo. It is exported for tooling that walks the graph; it
+// cannot be implemented outside this package.
+type Computation interface {
+	computation
+	// Kind reports what sort of computation this is: "effect", "memo" or
+	// "probe".
+	Kind() string
+	// Name returns the name given with the Name option, or "" if none.
+	Name() string
+}
+
+func (e *effect) Kind() string { return "effect" }
+func (e *effect) Name() string { return e.name }
+
+func (m *memo[T]) Kind() string { return "memo" }
+func (m *memo[T]) Name() string { return "" }
+
+// subscriberLister is implemented by every source that can report its
+// current subscribers.
+type subscriberLister interface {
+	subscriberSnapshot() []computation
+}
+
+// Subscribers returns a snapshot of the computations currently subscribed
+// to r, in no particular order. It returns nil if r is not a source this
+// package knows how to inspect.
+func Subscribers[T any](r Readonly[T]) []Computation {
+	lister, ok := r.(subscriberLister)
+	if !ok {
+		return nil
+	}
+	var out []Computation
+	for _, c := range lister.subscriberSnapshot() {
+		if c, ok := c.(Computation); ok {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// Dependents returns a snapshot of the effects that would re-run if r
+// changed: its direct subscribers and, following through memos, their
+// subscribers in turn. Each appears once, in no particular order. It returns
+// nil if r is not a source this package knows how to inspect.
+func Dependents[T any](r Readonly[T]) []Computation {
+	lister, ok := r.(subscriberLister)
+	if !ok {
+		return nil
+	}
+	var out []Computation
+	seen := make(map[computation]bool)
+	queue := lister.subscriberSnapshot()
+	for len(queue) > 0 {
+		c := queue[0]
+		queue = queue[1:]
+		if seen[c] {
+			continue
+		}
+		seen[c] = true
+		if next, ok := c.(subscriberLister); ok {
+			queue = append(queue, next.subscriberSnapshot()...)
+			continue
+		}
+		if c, ok := c.(Computation); ok && c.Kind() == "effect" {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+func (s *signal[T]) subscriberSnapshot() []computation {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.snapshotSubscribers()
+}
+
+func (a *AtomicInt64) subscriberSnapshot() []computation {
+	return a.subs.snapshot()
+}
+
+func (a *AtomicBool) subscriberSnapshot() []computation {
+	return a.subs.snapshot()
+}
+
+func (c *Counter) subscriberSnapshot() []computation {
+	return c.sig.(subscriberLister).subscriberSnapshot()
+}
@@ -0,0 +1,73 @@
+package signals
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSubscribers_ReportsEveryDependent(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 0)
+	Effect(s, func() { _ = count.Get() }, Name("logger"))
+	Effect(s, func() { _ = count.Get() }, Name("renderer"))
+	doubled := Memo(s, func() int { return count.Get() * 2 })
+	_ = doubled.Get()
+
+	var names []string
+	kinds := map[string]int{}
+	for _, c := range Subscribers(count) {
+		names = append(names, c.Name())
+		kinds[c.Kind()]++
+	}
+	slices.Sort(names)
+
+	if want := []string{"", "logger", "renderer"}; !slices.Equal(names, want) {
+		t.Errorf("Expected subscribers named %v, got %v", want, names)
+	}
+	if kinds["effect"] != 2 || kinds["memo"] != 1 {
+		t.Errorf("Expected two effects and one memo, got %v", kinds)
+	}
+}
+
+func TestSubscribers_ReturnsSnapshot(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := NewAtomicInt64(s, 0)
+	stop := Effect(s, func() { _ = count.Get() })
+
+	subs := Subscribers[int64](count)
+	stop()
+
+	if len(subs) != 1 {
+		t.Errorf("Expected the snapshot to keep the stopped effect, got %d", len(subs))
+	}
+	if got := Subscribers[int64](count); len(got) != 0 {
+		t.Errorf("Expected no subscribers after stop, got %d", len(got))
+	}
+}
+
+func TestDependents_FollowsThroughMemos(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	price := New(s, 10)
+	taxed := Memo(s, func() float64 { return float64(price.Get()) * 1.2 })
+	Effect(s, func() { _ = taxed.Get() }, Name("render total"))
+	Effect(s, func() { _ = price.Get() }, Name("log price"))
+	Effect(s, func() { _ = taxed.Get(); _ = price.Get() }, Name("both"))
+
+	var names []string
+	for _, c := range Dependents(price) {
+		names = append(names, c.Name())
+	}
+	slices.Sort(names)
+	if want := []string{"both", "log price", "render total"}; !slices.Equal(names, want) {
+		t.Errorf("Expected dependents %v, got %v", want, names)
+	}
+}
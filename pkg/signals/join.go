@@ -0,0 +1,76 @@
+package signals
+
+import "maps"
+
+// Join returns a map holding combine(left[k], right[k]) for every key k
+// present in both left and right. When one side changes, only that side's
+// entries are recombined; the other side is consulted by lookup. Each change
+// produces a fresh map, so previously returned maps are never mutated.
+func Join[K comparable, A, B, R any](s *Scope, left Readonly[map[K]A], right Readonly[map[K]B], combine func(A, B) R) Readonly[map[K]R] {
+	var (
+		l       map[K]A
+		r       map[K]B
+		current = make(map[K]R)
+	)
+	Untrack(s, func() {
+		l, r = left.Get(), right.Get()
+	})
+	for k, a := range l {
+		if b, ok := r[k]; ok {
+			current[k] = combine(a, b)
+		}
+	}
+	out := New(s, current)
+
+	leftStarted := false
+	stopLeft := Effect(s, func() {
+		next := left.Get()
+		if !leftStarted {
+			leftStarted = true
+			return
+		}
+
+		res := maps.Clone(current)
+		for k := range l {
+			if _, ok := next[k]; !ok {
+				delete(res, k)
+			}
+		}
+		for k, a := range next {
+			if b, ok := r[k]; ok {
+				res[k] = combine(a, b)
+			}
+		}
+		l, current = next, res
+		out.Set(res)
+	})
+
+	rightStarted := false
+	stopRight := Effect(s, func() {
+		next := right.Get()
+		if !rightStarted {
+			rightStarted = true
+			return
+		}
+
+		res := maps.Clone(current)
+		for k := range r {
+			if _, ok := next[k]; !ok {
+				delete(res, k)
+			}
+		}
+		for k, b := range next {
+			if a, ok := l[k]; ok {
+				res[k] = combine(a, b)
+			}
+		}
+		r, current = next, res
+		out.Set(res)
+	})
+
+	onScopeCleanup(s, func() {
+		stopLeft()
+		stopRight()
+	})
+	return out
+}
@@ -0,0 +1,103 @@
+package signals
+
+import (
+	"maps"
+	"strconv"
+	"testing"
+)
+
+type user struct {
+	Name string
+}
+
+func TestJoin_ProducesKeysPresentOnBothSides(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	users := New(s, map[int]user{1: {"alice"}, 2: {"bob"}})
+	scores := New(s, map[int]int{1: 10, 3: 30})
+	joined := Join(s, users, scores, func(u user, score int) string {
+		return u.Name + ":" + strconv.Itoa(score)
+	})
+
+	want := map[int]string{1: "alice:10"}
+	if got := joined.Get(); !maps.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestJoin_UpdatesWhenEitherSideChanges(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	left := New(s, map[string]int{"a": 1, "b": 2})
+	right := New(s, map[string]int{"a": 10})
+	joined := Join(s, left, right, func(a, b int) int { return a + b })
+
+	runCount := 0
+	Effect(s, func() {
+		_ = joined.Get()
+		runCount++
+	})
+
+	steps := []struct {
+		name  string
+		apply func()
+		want  map[string]int
+	}{
+		{"add key to right", func() { right.Set(map[string]int{"a": 10, "b": 20}) }, map[string]int{"a": 11, "b": 22}},
+		{"remove key from left", func() { left.Set(map[string]int{"b": 2}) }, map[string]int{"b": 22}},
+		{"add key to left", func() { left.Set(map[string]int{"a": 5, "b": 2}) }, map[string]int{"a": 15, "b": 22}},
+		{"remove key from right", func() { right.Set(map[string]int{"a": 10}) }, map[string]int{"a": 15}},
+		{"change value on right", func() { right.Set(map[string]int{"a": 100}) }, map[string]int{"a": 105}},
+	}
+
+	for i, step := range steps {
+		step.apply()
+		if got := joined.Get(); !maps.Equal(got, step.want) {
+			t.Errorf("%s: expected %v, got %v", step.name, step.want, got)
+		}
+		if runCount != i+2 {
+			t.Errorf("%s: expected downstream effect to have run %d times, got %d", step.name, i+2, runCount)
+		}
+	}
+}
+
+func TestJoin_RecombinesOnlyTheChangedSide(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	left := New(s, map[int]int{1: 1, 2: 2, 3: 3})
+	right := New(s, map[int]int{1: 1})
+	calls := 0
+	_ = Join(s, left, right, func(a, b int) int {
+		calls++
+		return a * b
+	})
+
+	calls = 0
+	right.Set(map[int]int{1: 1, 2: 2})
+	if calls != 2 {
+		t.Errorf("Expected only keys on the changed right side to be combined, got %d calls", calls)
+	}
+}
+
+func TestJoin_DoesNotMutatePreviousResult(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	left := New(s, map[int]int{1: 1})
+	right := New(s, map[int]int{1: 1})
+	joined := Join(s, left, right, func(a, b int) int { return a + b })
+
+	before := joined.Get()
+	right.Set(map[int]int{1: 5})
+
+	if before[1] != 2 {
+		t.Errorf("Expected earlier result to be unchanged, got %v", before)
+	}
+}
@@ -0,0 +1,11 @@
+package signals
+
+// Keyed returns a value holding keyFn applied to src, which only notifies its
+// subscribers when the key changes: a change of src that keeps its key, such
+// as an edit to an attribute of the same record, is absorbed. keyFn should
+// be cheap, as it runs on every change of src.
+func Keyed[T any, K comparable](s *Scope, src Readonly[T], keyFn func(T) K) Readonly[K] {
+	return derived(s, func() K {
+		return keyFn(src.Get())
+	})
+}
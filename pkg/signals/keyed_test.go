@@ -0,0 +1,32 @@
+package signals
+
+import "testing"
+
+func TestKeyed_NotifiesOnlyWhenKeyChanges(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	type user struct {
+		id   int
+		name string
+	}
+	src := New(s, user{1, "ada"})
+	key := Keyed(s, src, func(u user) int { return u.id })
+
+	runs := 0
+	Effect(s, func() {
+		_ = key.Get()
+		runs++
+	})
+
+	src.Set(user{1, "Ada"})
+	src.Update(func(u *user) { u.name = "Ada L." })
+	if runs != 1 || key.Get() != 1 {
+		t.Errorf("Expected the key to stay stable across edits, ran %d times with key %d", runs, key.Get())
+	}
+	src.Set(user{2, "grace"})
+	if runs != 2 || key.Get() != 2 {
+		t.Errorf("Expected a new key to notify once, ran %d times with key %d", runs, key.Get())
+	}
+}
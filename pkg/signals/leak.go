@@ -0,0 +1,75 @@
+package signals
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"weak"
+)
+
+// ErrLeakedSubscriptions is wrapped by the error reported by WithLeakCheck.
+var ErrLeakedSubscriptions = errors.New("signals: subscriptions leaked past close")
+
+// WithLeakCheck makes Close look for signals and memos that still have
+// subscribers once the root scope has been disposed, which means some
+// computation outlived its scope. The counts are reported to the handler set
+// by WithErrorHandler, or returned from Close if there is none.
+//
+// The engine keeps a weak reference to every signal created while the check
+// is on, so it doesn't keep them alive.
+func WithLeakCheck() Option {
+	return func(e *Engine) {
+		e.leakCheck = true
+	}
+}
+
+// A leakProbe counts a watched source's subscribers, reporting false once
+// the source has been garbage collected.
+type leakProbe func() (subs int, alive bool)
+
+// watchLeaks records src for the close-time leak scan, if enabled. Probes
+// of collected sources are pruned whenever the list would otherwise grow.
+func watchLeaks[P interface {
+	*E
+	subscriberLister
+}, E any](e *Engine, src P) {
+	if !e.leakCheck {
+		return
+	}
+	wp := weak.Make[E](src)
+	e.leakMu.Lock()
+	defer e.leakMu.Unlock()
+	if len(e.leakProbes) == cap(e.leakProbes) {
+		e.leakProbes = slices.DeleteFunc(e.leakProbes, func(probe leakProbe) bool {
+			_, alive := probe()
+			return !alive
+		})
+	}
+	e.leakProbes = append(e.leakProbes, func() (int, bool) {
+		if src := P(wp.Value()); src != nil {
+			return len(src.subscriberSnapshot()), true
+		}
+		return 0, false
+	})
+}
+
+// checkLeaks returns an error wrapping ErrLeakedSubscriptions if any watched
+// source still has subscribers.
+func (e *Engine) checkLeaks() error {
+	e.leakMu.Lock()
+	probes := e.leakProbes
+	e.leakProbes = nil
+	e.leakMu.Unlock()
+
+	var sources, subs int
+	for _, probe := range probes {
+		if n, _ := probe(); n > 0 {
+			sources++
+			subs += n
+		}
+	}
+	if sources == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %d signals still have %d subscribers", ErrLeakedSubscriptions, sources, subs)
+}
@@ -0,0 +1,80 @@
+package signals
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+)
+
+func TestWithLeakCheck_ReportsLeakedSubscriptions(t *testing.T) {
+	var reported []error
+	eng := Start(WithLeakCheck(), WithErrorHandler(func(err error) {
+		reported = append(reported, err)
+	}))
+	s := eng.Scope()
+
+	a := New(s, 0)
+	b := New(s, 0)
+	// A subscriber installed outside any effect is never cleaned up by the
+	// scope.
+	leaked := &recordingComputation{}
+	eng.withListener(leaked, func() {
+		_ = a.Get()
+		_ = b.Get()
+	})
+
+	if err := eng.Close(); err != nil {
+		t.Errorf("Expected the leak to go to the handler, Close returned %v", err)
+	}
+	if len(reported) != 1 || !errors.Is(reported[0], ErrLeakedSubscriptions) {
+		t.Fatalf("Expected one ErrLeakedSubscriptions report, got %v", reported)
+	}
+	if want := "signals: subscriptions leaked past close: 2 signals still have 2 subscribers"; reported[0].Error() != want {
+		t.Errorf("Expected %q, got %q", want, reported[0].Error())
+	}
+}
+
+func TestWithLeakCheck_CloseReturnsLeakWithoutHandler(t *testing.T) {
+	eng := Start(WithLeakCheck())
+	a := New(eng.Scope(), 0)
+	eng.withListener(&recordingComputation{}, func() {
+		_ = a.Get()
+	})
+
+	if err := eng.Close(); !errors.Is(err, ErrLeakedSubscriptions) {
+		t.Errorf("Expected Close to return ErrLeakedSubscriptions, got %v", err)
+	}
+}
+
+func TestWithLeakCheck_ScopedComputationsDoNotLeak(t *testing.T) {
+	eng := Start(WithLeakCheck())
+	s := eng.Scope()
+
+	a := New(s, 1)
+	doubled := Memo(s, func() int { return a.Get() * 2 })
+	Effect(s, func() { _ = doubled.Get() })
+	EffectAfter(s, 2, func() { _ = a.Get() })
+
+	if err := eng.Close(); err != nil {
+		t.Errorf("Expected no leaks, got %v", err)
+	}
+}
+
+func TestWithLeakCheck_PrunesCollectedSignals(t *testing.T) {
+	eng := Start(WithLeakCheck())
+	defer eng.Close()
+	s := eng.Scope()
+
+	for i := range 4096 {
+		_ = New(s, i)
+		if i%256 == 0 {
+			runtime.GC()
+		}
+	}
+	eng.leakMu.Lock()
+	n := len(eng.leakProbes)
+	eng.leakMu.Unlock()
+	if n >= 2048 {
+		t.Errorf("Expected probes of collected signals to be pruned, still holding %d", n)
+	}
+}
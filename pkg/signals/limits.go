@@ -0,0 +1,35 @@
+package signals
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrTooManySubscribers is reported when a signal's subscriber count exceeds
+// the limit set with WithMaxSubscribers.
+var ErrTooManySubscribers = errors.New("signals: too many subscribers")
+
+// WithMaxSubscribers sets a limit on the number of subscribers any one
+// signal, memo or trigger should have. Subscriptions beyond it still work,
+// but the first one past the limit reports an error wrapping
+// ErrTooManySubscribers, naming the signal if it has a name, to the handler
+// set by WithErrorHandler. It is reported again each time the count falls
+// back to the limit and exceeds it anew. Without a handler the limit has no
+// effect.
+func WithMaxSubscribers(n int) Option {
+	return func(e *Engine) {
+		e.maxSubscribers = n
+	}
+}
+
+// checkSubscribers reports a signal whose subscriber count has just grown
+// to n if that crosses the limit.
+func (e *Engine) checkSubscribers(n int, name string) {
+	if e.maxSubscribers <= 0 || n != e.maxSubscribers+1 {
+		return
+	}
+	if name == "" {
+		name = "unnamed signal"
+	}
+	e.reportError(fmt.Errorf("%w: %s has more than %d", ErrTooManySubscribers, name, e.maxSubscribers))
+}
@@ -0,0 +1,33 @@
+package signals
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestWithMaxSubscribers_ReportsWhenExceeded(t *testing.T) {
+	var reported []error
+	eng := Start(WithMaxSubscribers(3), WithErrorHandler(func(err error) {
+		reported = append(reported, err)
+	}))
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := NewNamed(s, "count", 0)
+	for range 3 {
+		Effect(s, func() { _ = count.Get() })
+	}
+	if len(reported) != 0 {
+		t.Fatalf("Expected no report at the limit, got %v", reported)
+	}
+
+	for range 2 {
+		Effect(s, func() { _ = count.Get() })
+	}
+	if len(reported) != 1 || !errors.Is(reported[0], ErrTooManySubscribers) {
+		t.Fatalf("Expected one ErrTooManySubscribers report, got %v", reported)
+	}
+	if want := "signals: too many subscribers: count has more than 3"; reported[0].Error() != want {
+		t.Errorf("Expected %q, got %q", want, reported[0].Error())
+	}
+}
@@ -0,0 +1,194 @@
+package signals
+
+import (
+	"sync/atomic"
+	"testing"
+)
+
+// recordingComputation is a minimal computation that records the sources it
+// was subscribed to and how many times it was notified.
+type recordingComputation struct {
+	sources  []subscribable
+	notified int
+}
+
+func (r *recordingComputation) notify() {
+	r.notified++
+}
+
+func (r *recordingComputation) addSource(s subscribable) {
+	r.sources = append(r.sources, s)
+}
+
+func TestProbe_RunCapturesDependency(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 10)
+	p := NewProbe(eng)
+	p.Run(func() {
+		_ = count.Get()
+	})
+
+	if subs := Subscribers(count); len(subs) != 1 || subs[0] != p {
+		t.Fatalf("Expected the probe to be count's only subscriber, got %v", subs)
+	}
+	count.Set(20)
+	if got := p.Notified(); got != 1 {
+		t.Errorf("Expected the probe to be notified once, got %d", got)
+	}
+
+	p.Stop()
+	count.Set(30)
+	if got := p.Notified(); got != 1 {
+		t.Errorf("Expected a stopped probe not to be notified, got %d", got)
+	}
+}
+
+func TestProbe_RunRestoresPreviousListener(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 10)
+	p := NewProbe(eng)
+	p.Run(func() {})
+
+	_ = count.Get()
+	if n := len(Subscribers(count)); n != 0 {
+		t.Errorf("Expected no dependency outside Run, got %d", n)
+	}
+}
+
+func TestEngine_ListenerDepthReturnsToZero(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	a := New(s, 1)
+	doubled := Memo(s, func() int { return a.Get() * 2 })
+	var inside int
+	Effect(s, func() {
+		_ = doubled.Get()
+		inside = eng.ListenerDepth()
+	})
+	a.Set(2)
+
+	if inside != 1 {
+		t.Errorf("Expected depth 1 inside the effect, got %d", inside)
+	}
+	if got := eng.ListenerDepth(); got != 0 {
+		t.Errorf("Expected depth 0 once idle, got %d", got)
+	}
+}
+
+func TestEngine_ListenerDepthIsZeroAfterRecoveredPanic(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	a := New(s, 0)
+	broken := Memo(s, func() int {
+		if v := a.Get(); v > 0 {
+			panic("boom")
+		}
+		return 0
+	})
+	Effect(s, func() { _ = broken.Get() })
+
+	capturePanic(func() { a.Set(1) })
+	if got := eng.ListenerDepth(); got != 0 {
+		t.Errorf("Expected depth 0 after the panic unwound, got %d", got)
+	}
+}
+
+func TestEngine_EffectsOnSeparateGoroutinesTrackTheirOwnReads(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	a, a2 := New(s, 0), New(s, 0)
+	b, b2 := New(s, 0), New(s, 0)
+	var aRuns, bRuns atomic.Int64
+	// Interleave the re-runs so each effect reads while the other's run
+	// started more recently.
+	aIn, bIn, aRead := make(chan struct{}), make(chan struct{}), make(chan struct{})
+	Effect(s, func() {
+		if a.Get() == 1 && aRuns.Load() == 1 {
+			close(aIn)
+			<-bIn
+		}
+		_ = a2.Get()
+		if a.Peek() == 1 && aRuns.Load() == 1 {
+			close(aRead)
+		}
+		aRuns.Add(1)
+	})
+	Effect(s, func() {
+		if b.Get() == 1 && bRuns.Load() == 1 {
+			close(bIn)
+			<-aRead
+		}
+		_ = b2.Get()
+		bRuns.Add(1)
+	})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		a.Set(1)
+	}()
+	<-aIn
+	b.Set(1)
+	<-done
+
+	a2.Set(1)
+	if aRuns.Load() != 3 || bRuns.Load() != 2 {
+		t.Errorf("Expected a2 to re-run only the first effect, got runs %d and %d", aRuns.Load(), bRuns.Load())
+	}
+	b2.Set(1)
+	if aRuns.Load() != 3 || bRuns.Load() != 3 {
+		t.Errorf("Expected b2 to re-run only the second effect, got runs %d and %d", aRuns.Load(), bRuns.Load())
+	}
+	if got := eng.ListenerDepth(); got != 0 {
+		t.Errorf("Expected no listeners left, got depth %d", got)
+	}
+}
+
+func BenchmarkEffectRerun(b *testing.B) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	x := New(s, 0)
+	y := New(s, 0)
+	sum := Memo(s, func() int { return x.Get() + y.Get() })
+	Effect(s, func() {
+		_ = x.Get()
+		_ = y.Get()
+		_ = sum.Get()
+	})
+
+	for i := 0; b.Loop(); i++ {
+		x.Set(i + 1)
+	}
+}
+
+func BenchmarkEffectRerunParallel(b *testing.B) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	b.RunParallel(func(pb *testing.PB) {
+		x := New(s, 0)
+		y := New(s, 0)
+		Effect(s, func() {
+			_ = x.Get()
+			_ = y.Get()
+		})
+		for i := 0; pb.Next(); i++ {
+			x.Set(i + 1)
+		}
+	})
+}
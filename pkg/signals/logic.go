@@ -0,0 +1,31 @@
+package signals
+
+// All returns a computed value that is true when every source is true. It
+// short-circuits: sources after the first false one are not read and so are
+// not dependencies until the result depends on them again. All of no
+// sources is true.
+func All(s *Scope, sources ...Readonly[bool]) Readonly[bool] {
+	return Memo(s, func() bool {
+		for _, src := range sources {
+			if !src.Get() {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// Any returns a computed value that is true when at least one source is
+// true. It short-circuits: sources after the first true one are not read and
+// so are not dependencies until the result depends on them again. Any of no
+// sources is false.
+func Any(s *Scope, sources ...Readonly[bool]) Readonly[bool] {
+	return Memo(s, func() bool {
+		for _, src := range sources {
+			if src.Get() {
+				return true
+			}
+		}
+		return false
+	})
+}
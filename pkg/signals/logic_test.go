@@ -0,0 +1,99 @@
+package signals
+
+import "testing"
+
+func TestAll_ComputesConjunction(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	a := New(s, true)
+	b := New(s, true)
+	all := All(s, a, b)
+
+	if !all.Get() {
+		t.Error("Expected true when all sources are true")
+	}
+	b.Set(false)
+	if all.Get() {
+		t.Error("Expected false when a source is false")
+	}
+	if !All(s).Get() {
+		t.Error("Expected All of no sources to be true")
+	}
+}
+
+func TestAll_ShortCircuitsLaterSources(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	a := New(s, false)
+	b := New(s, true)
+	all := All(s, a, b)
+
+	runCount := 0
+	Effect(s, func() {
+		_ = all.Get()
+		runCount++
+	})
+
+	b.Set(false)
+	b.Set(true)
+	if runCount != 1 {
+		t.Errorf("Expected changes past the deciding source not to re-run, ran %d times", runCount)
+	}
+
+	a.Set(true)
+	if runCount != 2 || !all.Get() {
+		t.Fatalf("Expected re-run with true after a flipped, ran %d times", runCount)
+	}
+
+	b.Set(false)
+	if runCount != 3 || all.Get() {
+		t.Errorf("Expected b to be tracked once a no longer decides, ran %d times", runCount)
+	}
+}
+
+func TestAny_ComputesDisjunction(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	a := New(s, false)
+	b := New(s, false)
+	anyTrue := Any(s, a, b)
+
+	if anyTrue.Get() {
+		t.Error("Expected false when no source is true")
+	}
+	b.Set(true)
+	if !anyTrue.Get() {
+		t.Error("Expected true when a source is true")
+	}
+	if Any(s).Get() {
+		t.Error("Expected Any of no sources to be false")
+	}
+}
+
+func TestAny_ShortCircuitsLaterSources(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	a := New(s, true)
+	b := New(s, false)
+	anyTrue := Any(s, a, b)
+
+	runCount := 0
+	Effect(s, func() {
+		_ = anyTrue.Get()
+		runCount++
+	})
+
+	b.Set(true)
+	b.Set(false)
+	if runCount != 1 {
+		t.Errorf("Expected changes past the deciding source not to re-run, ran %d times", runCount)
+	}
+}
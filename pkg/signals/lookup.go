@@ -0,0 +1,17 @@
+package signals
+
+// Lookup returns a value holding table[key], or fallback while key is
+// absent from table, kept up to date as either changes.
+//
+// Every change to the table is re-resolved, but when V is comparable a change
+// that leaves table[key] as it was, such as an edit to an unrelated entry,
+// does not notify the result's subscribers. For other V every change to key
+// or table notifies.
+func Lookup[K comparable, V any](s *Scope, key Readonly[K], table Readonly[map[K]V], fallback V) Readonly[V] {
+	return derived(s, func() V {
+		if v, ok := table.Get()[key.Get()]; ok {
+			return v
+		}
+		return fallback
+	})
+}
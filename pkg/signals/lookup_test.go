@@ -0,0 +1,54 @@
+package signals
+
+import "testing"
+
+func TestLookup_FollowsKeyAndTable(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	key := New(s, "en")
+	table := New(s, map[string]string{"en": "hello", "fr": "bonjour"})
+	greeting := Lookup(s, key, table, "?")
+
+	if got := greeting.Get(); got != "hello" {
+		t.Errorf("Expected %q, got %q", "hello", got)
+	}
+	key.Set("fr")
+	if got := greeting.Get(); got != "bonjour" {
+		t.Errorf("Expected the key change to resolve %q, got %q", "bonjour", got)
+	}
+	table.Set(map[string]string{"fr": "salut"})
+	if got := greeting.Get(); got != "salut" {
+		t.Errorf("Expected the table change to resolve %q, got %q", "salut", got)
+	}
+	key.Set("de")
+	if got := greeting.Get(); got != "?" {
+		t.Errorf("Expected the fallback for a missing key, got %q", got)
+	}
+}
+
+func TestLookup_UnrelatedEntryDoesNotNotify(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	key := New(s, "a")
+	table := New(s, map[string]int{"a": 1, "b": 2})
+	v := Lookup(s, key, table, 0)
+
+	runs := 0
+	Effect(s, func() {
+		_ = v.Get()
+		runs++
+	})
+
+	table.Set(map[string]int{"a": 1, "b": 3})
+	if runs != 1 {
+		t.Errorf("Expected an edit to another entry not to notify, ran %d times", runs)
+	}
+	table.Set(map[string]int{"a": 5})
+	if runs != 2 || v.Get() != 5 {
+		t.Errorf("Expected the looked-up entry's change to notify, ran %d times with %d", runs, v.Get())
+	}
+}
@@ -0,0 +1,88 @@
+package signals
+
+import (
+	"context"
+	"sync"
+)
+
+// MapAsync returns a signal holding the result of running fn on the latest
+// value of src. Every change of src starts a new call on its own goroutine
+// and cancels the context of any call still in flight, so only the result
+// for the latest input is ever stored. Errors, and results of superseded
+// calls, are discarded. The signal holds B's zero value until the first call
+// succeeds. In-flight calls are cancelled when s is disposed.
+func MapAsync[A, B any](s *Scope, src Readonly[A], fn func(context.Context, A) (B, error)) Readonly[B] {
+	var zero B
+	out := New(s, zero)
+	r := &asyncResult[B]{Readonly: out, settled: NewTrigger(s)}
+
+	cancel := context.CancelFunc(func() {})
+	start := func(v A) {
+		r.mu.Lock()
+		cancel()
+		r.gen++
+		myGen := r.gen
+		var ctx context.Context
+		ctx, cancel = context.WithCancel(context.Background())
+		r.mu.Unlock()
+		r.settled.Fire()
+
+		go func() {
+			result, err := fn(ctx, v)
+			// Hold publish from the check to the Set, so a newer call that
+			// starts in between can only store its result after this one.
+			r.publish.Lock()
+			defer r.publish.Unlock()
+			r.mu.Lock()
+			latest := myGen == r.gen && ctx.Err() == nil
+			r.mu.Unlock()
+			if !latest {
+				return
+			}
+			if err == nil {
+				out.Set(result)
+			}
+			r.mu.Lock()
+			if myGen == r.gen {
+				r.done = myGen
+			}
+			r.mu.Unlock()
+			r.settled.Fire()
+		}()
+	}
+
+	stop := Effect(s, func() {
+		v := src.Get()
+		Untrack(s, func() {
+			start(v)
+		})
+	})
+	onScopeCleanup(s, func() {
+		stop()
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		cancel()
+	})
+	return r
+}
+
+// asyncResult is the signal returned by MapAsync. It is stale while the
+// call for the latest input is in flight.
+type asyncResult[T any] struct {
+	Readonly[T]
+	mu      sync.Mutex
+	gen     uint64 // generation of the latest call started
+	done    uint64 // generation of the latest call settled
+	settled *Trigger
+	// publish serializes storing results. It is not held by start, so
+	// subscribers notified of a result are free to change the input.
+	publish sync.Mutex
+}
+
+func (a *asyncResult[T]) peekStatus() (T, bool) {
+	a.settled.Track()
+	v := a.Get()
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return v, a.done != a.gen
+}
@@ -0,0 +1,173 @@
+package signals
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestMapAsync_CancelsStaleCalls(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := New(s, 0)
+	release := make(chan struct{})
+	cancelled := make(chan int, 10)
+	out := MapAsync(s, src, func(ctx context.Context, v int) (int, error) {
+		if v < 3 {
+			<-ctx.Done()
+			cancelled <- v
+			return 0, ctx.Err()
+		}
+		<-release
+		return v * 10, nil
+	})
+
+	results := make(chan int, 10)
+	Effect(s, func() {
+		results <- out.Get()
+	})
+	<-results // initial zero value
+
+	src.Set(1)
+	src.Set(2)
+	src.Set(3)
+
+	seen := map[int]bool{}
+	for range 3 {
+		select {
+		case v := <-cancelled:
+			seen[v] = true
+		case <-time.After(time.Second):
+			t.Fatalf("Expected calls for 0, 1 and 2 to be cancelled, saw %v", seen)
+		}
+	}
+
+	close(release)
+	select {
+	case v := <-results:
+		if v != 30 {
+			t.Errorf("Expected the result for the last input, got %d", v)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected the latest call's result to be delivered")
+	}
+
+	select {
+	case v := <-results:
+		t.Errorf("Expected only one result to be delivered, also got %d", v)
+	default:
+	}
+}
+
+func TestMapAsync_IgnoresErrors(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := New(s, 1)
+	out := MapAsync(s, src, func(ctx context.Context, v int) (string, error) {
+		if v < 0 {
+			return "", errors.New("negative")
+		}
+		return "ok", nil
+	})
+	settled := awaitSettled(s, out)
+
+	if v := settled(t); v != "ok" {
+		t.Fatalf("Expected first result %q, got %q", "ok", v)
+	}
+	src.Set(-1)
+	if v := settled(t); v != "ok" {
+		t.Errorf("Expected a failed call to keep the last successful result, got %q", v)
+	}
+}
+
+func TestMapAsync_StaleResultNeverOverwritesNewer(t *testing.T) {
+	var src Signal[int]
+	var out Readonly[string]
+	var once sync.Once
+	returned, firstStored := make(chan struct{}), make(chan struct{})
+	// Start a newer call while the first result is being stored, and give
+	// it every chance to store its own result before the first Set lands.
+	eng := Start(WithWriteMiddleware(func(next func(string, any)) func(string, any) {
+		return func(name string, v any) {
+			if v == "10" {
+				once.Do(func() {
+					src.Set(2)
+					<-returned
+					for i := 0; i < 1000 && out.Peek() != "20"; i++ {
+						runtime.Gosched()
+					}
+				})
+			}
+			next(name, v)
+			if v == "10" {
+				close(firstStored)
+			}
+		}
+	}))
+	defer eng.Close()
+	s := eng.Scope()
+
+	src = New(s, 1)
+	out = MapAsync(s, src, func(ctx context.Context, v int) (string, error) {
+		if v == 2 {
+			defer close(returned)
+		}
+		return strconv.Itoa(v * 10), nil
+	})
+	settled := awaitSettled(s, out)
+
+	<-firstStored
+	settled(t)
+	if v := out.Peek(); v != "20" {
+		t.Errorf("Expected the newer result to win, got %q", v)
+	}
+}
+
+// awaitSettled returns a function that waits for r's next settled value.
+func awaitSettled[T any](s *Scope, r Readonly[T]) func(*testing.T) T {
+	status := WithStatus(s, r)
+	values := make(chan T, 16)
+	Effect(s, func() {
+		if st := status.Get(); !st.Stale {
+			values <- st.Value
+		}
+	})
+	return func(t *testing.T) T {
+		t.Helper()
+		select {
+		case v := <-values:
+			return v
+		case <-time.After(time.Second):
+			t.Fatal("Expected the result to settle")
+			panic("unreachable")
+		}
+	}
+}
+
+func TestMapAsync_DisposeCancelsInFlight(t *testing.T) {
+	eng := Start()
+	s := eng.Scope()
+
+	src := New(s, 1)
+	cancelled := make(chan struct{})
+	_ = MapAsync(s, src, func(ctx context.Context, v int) (int, error) {
+		<-ctx.Done()
+		close(cancelled)
+		return 0, ctx.Err()
+	})
+
+	eng.Close()
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("Expected dispose to cancel the in-flight call")
+	}
+}
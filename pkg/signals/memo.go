@@ -1,73 +1,375 @@
 package signals
 
+import (
+	"errors"
+	"reflect"
+	"time"
+)
+
+// ErrNilMemoFunc is the panic value raised when a memo is created with a nil
+// function.
+var ErrNilMemoFunc = errors.New("signals: memo function must not be nil")
+
 type memo[T any] struct {
 	signal[T]
 	fn      func() T
 	isDirty bool
-	sources map[subscribable]struct{}
+	// computed records whether fn has ever run.
+	computed bool
+	// deferNotify makes the memo notify its subscribers in a microtask
+	// rather than as soon as it is invalidated.
+	deferNotify bool
+	sources     map[subscribable]struct{}
+
+	// refreshed holds readers of the memo's status, notified when a stale
+	// value is recomputed.
+	refreshed subscriberSet
+
+	// guard, if set, is consulted when a dependency changes; returning false
+	// keeps the cached value and skips invalidation.
+	guard func() bool
+
+	// afterRun, if set, is told about every recomputation, with whether
+	// there was a previous value; returning true detaches the memo from its
+	// sources. It is called with m.mu held.
+	afterRun func(prev, next T, hadPrev bool) (detach bool)
+	// expired, if set, is consulted on every read of a clean memo;
+	// returning true recomputes it as if a dependency had changed.
+	expired func() bool
 }
 
 // Memo creates a new computed signal.
 // It's lazy, only re-computing its value when read and a dependency has changed.
-func Memo[T any](s *Scope, fn func() T) Readonly[T] {
+// A memo only stays subscribed to its sources while something depends on it:
+// once it has no subscribers, the next source change detaches it until it is
+// read again.
+//
+// When a dependency changes while the memo has subscribers, it recomputes
+// right away and only notifies them if the value changed, so a memo that
+// settles on the same value doesn't re-run what depends on it. Values are
+// compared with == for comparable types, or with the function given by
+// WithEquals; without either, every change notifies. A memo whose status is
+// watched with WithStatus stays lazy so it can be seen going stale, and
+// notifies on every change.
+//
+// The returned value also has a Computed() bool method, reporting whether the
+// memo has computed a value yet, for tooling to tell "never read" from
+// "cached".
+func Memo[T any](s *Scope, fn func() T, opts ...MemoOption[T]) Readonly[T] {
+	m := newMemo(s, fn)
+	for _, opt := range opts {
+		opt(&m.signal)
+	}
+	return m
+}
+
+// MemoOption configures a memo created with Memo. WithEquals sets how its
+// values are compared.
+type MemoOption[T any] = SignalOption[T]
+
+// MemoWithGuard is like Memo, but when a dependency changes it first asks
+// shouldRecompute. If that returns false the memo stays clean, keeps its
+// cached value and doesn't notify its subscribers; the dependency change is
+// not remembered, so the guard is consulted afresh on the next change.
+// shouldRecompute runs untracked. As with Memo, a recomputation that yields
+// an equal value doesn't notify subscribers.
+func MemoWithGuard[T any](s *Scope, fn func() T, shouldRecompute func() bool) Readonly[T] {
+	m := newMemo(s, fn)
+	m.guard = shouldRecompute
+	return m
+}
+
+// KeyedMemo is like Memo, but only keyFn is tracked: fn reruns, untracked,
+// when keyFn returns a different key, and otherwise the cached value is
+// kept no matter what else fn reads. Subscribers are only notified when the
+// value changes, compared as Memo compares it.
+func KeyedMemo[K comparable, T any](s *Scope, keyFn func() K, fn func() T) Readonly[T] {
+	if keyFn == nil || fn == nil {
+		panic(ErrNilMemoFunc)
+	}
+	var (
+		lastKey  K
+		cached   T
+		computed bool
+	)
+	return Memo(s, func() T {
+		key := keyFn()
+		if computed && key == lastKey {
+			return cached
+		}
+		Untrack(s, func() {
+			cached = fn()
+		})
+		lastKey, computed = key, true
+		return cached
+	})
+}
+
+// A Dependency is a source listed for ExplicitMemo or EffectHandle.Track;
+// create one with Dep.
+type Dependency struct {
+	touch func()
+	// src is the wrapped source, identifying the dependency.
+	src any
+}
+
+// Dep wraps r as a Dependency.
+func Dep[T any](r Readonly[T]) Dependency {
+	return Dependency{touch: func() { _ = r.Get() }, src: r}
+}
+
+// same reports whether d and other wrap the same source.
+func (d Dependency) same(other Dependency) bool {
+	if d.src == nil || other.src == nil || !reflect.TypeOf(d.src).Comparable() {
+		return false
+	}
+	return d.src == other.src
+}
+
+// ExplicitMemo is like Memo, but it subscribes to exactly deps and runs fn
+// untracked, so reads inside fn never add dependencies.
+func ExplicitMemo[T any](s *Scope, deps []Dependency, fn func() T) Readonly[T] {
+	if fn == nil {
+		panic(ErrNilMemoFunc)
+	}
+	return Memo(s, func() (v T) {
+		for _, d := range deps {
+			d.touch()
+		}
+		Untrack(s, func() {
+			v = fn()
+		})
+		return v
+	})
+}
+
+// DeferredMemo is like Memo, but when it is invalidated its subscribers are
+// notified in a microtask: after the change that invalidated it has fully
+// propagated, including every effect it reached directly, yet before the
+// write that caused it returns. It decouples consumers of the memo from the
+// timing of its producers. Unlike a batch, no caller has to opt in. If the
+// memo recomputes to an equal value, compared as Memo does, the microtask
+// notifies no one.
+func DeferredMemo[T any](s *Scope, fn func() T) Readonly[T] {
+	m := newMemo(s, fn)
+	m.deferNotify = true
+	return m
+}
+
+// MemoTTL is like Memo, but a value older than ttl, by clock, is also
+// considered stale: the first read after it expires recomputes fn even if
+// no dependency changed. Expiry alone doesn't notify subscribers; it only
+// affects reads. A dependency change that recomputes an equal value doesn't
+// notify them either, as with Memo.
+func MemoTTL[T any](s *Scope, fn func() T, ttl time.Duration, clock Clock) Readonly[T] {
+	m := newMemo(s, fn)
+	var computedAt time.Time
+	m.afterRun = func(_, _ T, _ bool) bool {
+		computedAt = clock.Now()
+		return false
+	}
+	m.expired = func() bool {
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+		return m.computed && clock.Now().Sub(computedAt) >= ttl
+	}
+	return m
+}
+
+func newMemo[T any](s *Scope, fn func() T) *memo[T] {
+	if fn == nil {
+		panic(ErrNilMemoFunc)
+	}
 	m := &memo[T]{
 		signal: signal[T]{
 			scope:       s,
 			subscribers: make(map[computation]struct{}),
+			equals:      comparableEquals[T](),
 		},
 		fn:      fn,
 		isDirty: true, // Start dirty to compute on first Get()
 	}
-	OnCleanup(s, m.cleanup)
+	onScopeCleanup(s, m.cleanup)
+	watchLeaks(s.engine, m)
 	return m
 }
 
+// MemoOr is like Memo, but if fn panics the panic is recovered and fallback
+// is cached as the value instead. Reads fn made before panicking remain
+// dependencies, so the memo retries fn when any of them changes.
+func MemoOr[T any](s *Scope, fn func() T, fallback T) Readonly[T] {
+	if fn == nil {
+		panic(ErrNilMemoFunc)
+	}
+	return Memo(s, func() (v T) {
+		defer func() {
+			if recover() != nil {
+				v = fallback
+			}
+		}()
+		return fn()
+	})
+}
+
 func (m *memo[T]) Get() T {
-	if listener := m.scope.engine.listener; listener != nil {
+	m.scope.engine.checkRead()
+
+	m.subscribeListener()
+
+	if m.isDirty || (m.expired != nil && m.expired()) {
+		m.runComputation()
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.value
+}
+
+// Peek is like Get, recomputing a stale value, but doesn't subscribe the
+// active listener.
+func (m *memo[T]) Peek() T {
+	m.scope.engine.checkRead()
+
+	if m.isDirty || (m.expired != nil && m.expired()) {
+		m.runComputation()
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.value
+}
+
+func (m *memo[T]) subscribeListener() {
+	if listener := m.scope.engine.currentListener(); listener != nil {
 		m.mu.Lock()
 		if m.subscribers == nil {
 			m.subscribers = make(map[computation]struct{})
 		}
 		m.subscribers[listener] = struct{}{}
+		n := len(m.subscribers)
 		m.mu.Unlock()
+		m.scope.engine.checkSubscribers(n, "")
 		listener.addSource(m)
 	}
+}
 
-	if m.isDirty {
-		m.runComputation()
-	}
+// peekStatus returns the cached value and whether it is stale, without
+// recomputing. The reader is notified both when the memo goes stale and
+// when it is recomputed.
+func (m *memo[T]) peekStatus() (T, bool) {
+	m.refreshed.track(m, m.scope.engine)
+	m.subscribeListener()
 
 	m.mu.RLock()
 	defer m.mu.RUnlock()
-	return m.value
+	return m.value, m.isDirty
+}
+
+func (m *memo[T]) unsubscribe(c computation) {
+	m.signal.unsubscribe(c)
+	m.refreshed.remove(c)
 }
 
 func (m *memo[T]) runComputation() {
 	m.cleanup() // Clean up old dependencies before re-running
-	m.scope.engine.pushListener(m)
-	newValue := m.fn()
-	m.scope.engine.popListener()
+	newValue := m.track()
 
 	m.mu.Lock()
+	detach := m.afterRun != nil && m.afterRun(m.value, newValue, m.computed)
 	m.value = newValue
 	m.isDirty = false
+	m.computed = true
 	m.mu.Unlock()
+	if detach {
+		m.cleanup()
+	}
+	m.refreshed.notify(m.scope.engine)
+}
+
+// track runs fn with the memo as the active listener, restoring the previous
+// listener even if fn panics.
+func (m *memo[T]) track() T {
+	id := m.scope.engine.pushListener(m)
+	defer m.scope.engine.popListener(id)
+	return m.fn()
 }
 
 func (m *memo[T]) notify() {
+	if m.guard != nil && !m.allowRecompute() {
+		return
+	}
+
 	m.mu.Lock()
 	if m.isDirty {
 		m.mu.Unlock()
 		return
 	}
 	m.isDirty = true
+	prev, hadPrev := m.value, m.computed
+	subs := m.snapshotSubscribers()
 	m.mu.Unlock()
 
+	if len(subs) == 0 {
+		// Nothing depends on the memo any more, so go cold: detach from
+		// the sources until the next read subscribes it again.
+		m.cleanup()
+		return
+	}
+	// Recompute now if that lets an unchanged value stop here.
+	verify := m.equals != nil && hadPrev && m.refreshed.len() == 0
+	if m.deferNotify {
+		m.scope.engine.queueMicrotask(func() {
+			if verify && !m.changedSince(prev) {
+				return
+			}
+			m.mu.RLock()
+			subs := m.snapshotSubscribers()
+			m.mu.RUnlock()
+			m.scope.engine.notifyAll(subs)
+		})
+		return
+	}
+	if verify && !m.changedSince(prev) {
+		return
+	}
+	// As in propagate, a panicking subscriber doesn't stop the others.
+	var unhandled any
+	for _, sub := range subs {
+		if r := m.scope.engine.runGuarded(sub.notify); r != nil && unhandled == nil {
+			unhandled = r
+		}
+	}
+	if unhandled != nil {
+		panic(unhandled)
+	}
+}
+
+// changedSince brings the memo up to date and reports whether its value
+// differs from prev.
+func (m *memo[T]) changedSince(prev T) bool {
 	m.mu.RLock()
-	defer m.mu.RUnlock()
-	for sub := range m.subscribers {
-		sub.notify()
+	dirty := m.isDirty
+	m.mu.RUnlock()
+	if dirty {
+		m.runComputation()
 	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return !m.equals(prev, m.value)
+}
+
+// Computed reports whether fn has run at least once.
+func (m *memo[T]) Computed() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.computed
+}
+
+func (m *memo[T]) allowRecompute() (ok bool) {
+	Untrack(m.scope, func() {
+		ok = m.guard()
+	})
+	return ok
 }
 
 func (m *memo[T]) addSource(s subscribable) {
@@ -1,6 +1,10 @@
 package signals
 
-import "testing"
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
 
 func TestMemo_ReturnsComputedValue(t *testing.T) {
 	eng := Start()
@@ -187,3 +191,375 @@ func TestMemo_WorksWhenNested(t *testing.T) {
 		t.Errorf("Expected run counts to be 2 after update, got b=%d, c=%d", bRunCount, cRunCount)
 	}
 }
+
+func TestMemo_PanicLeavesListenerStackBalanced(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	a := New(s, 1)
+	m := Memo(s, func() int {
+		if a.Get() < 0 {
+			panic("negative")
+		}
+		return a.Get()
+	})
+
+	a.Set(-1)
+	if got := capturePanic(func() { _ = m.Get() }); got != "negative" {
+		t.Fatalf("Expected the memo panic to reach the caller, got %v", got)
+	}
+	if eng.currentListener() != nil || eng.ListenerDepth() != 0 {
+		t.Errorf("Expected an empty listener stack after the panic, got depth %d", eng.ListenerDepth())
+	}
+}
+
+func TestMemoOr_FallsBackOnPanicAndRecovers(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	divisor := New(s, 2)
+	runCount := 0
+	half := MemoOr(s, func() int {
+		runCount++
+		d := divisor.Get()
+		if d == 0 {
+			panic("division by zero")
+		}
+		return 10 / d
+	}, -1)
+
+	if val := half.Get(); val != 5 {
+		t.Fatalf("Expected 5, got %d", val)
+	}
+
+	divisor.Set(0)
+	if val := half.Get(); val != -1 {
+		t.Fatalf("Expected fallback -1 after a panic, got %d", val)
+	}
+	if val := half.Get(); val != -1 || runCount != 2 {
+		t.Errorf("Expected fallback to be cached, got %d after %d runs", val, runCount)
+	}
+
+	divisor.Set(5)
+	if val := half.Get(); val != 2 {
+		t.Errorf("Expected memo to recover to 2 once the input changed, got %d", val)
+	}
+}
+
+func TestMemoWithGuard_DefersRecomputationWhileGuardIsClosed(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	price := New(s, 100)
+	open := New(s, false)
+	runCount := 0
+	quote := MemoWithGuard(s, func() int {
+		runCount++
+		return price.Get()
+	}, func() bool {
+		return open.Get()
+	})
+
+	if val := quote.Get(); val != 100 || runCount != 1 {
+		t.Fatalf("Expected first read to compute 100, got %d after %d runs", val, runCount)
+	}
+
+	price.Set(110)
+	if val := quote.Get(); val != 100 || runCount != 1 {
+		t.Errorf("Expected cached 100 while the guard is closed, got %d after %d runs", val, runCount)
+	}
+
+	// Opening the gate alone doesn't invalidate: the guard is only consulted
+	// when a dependency changes.
+	open.Set(true)
+	if val := quote.Get(); val != 100 {
+		t.Errorf("Expected 100 until the next dependency change, got %d", val)
+	}
+
+	price.Set(120)
+	if val := quote.Get(); val != 120 || runCount != 2 {
+		t.Errorf("Expected recomputation to 120 once the guard allows it, got %d after %d runs", val, runCount)
+	}
+}
+
+func TestMemoWithGuard_GuardReadsAreUntracked(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	price := New(s, 1)
+	gate := New(s, true)
+	quote := MemoWithGuard(s, func() int { return price.Get() }, func() bool { return gate.Get() })
+
+	runCount := 0
+	Effect(s, func() {
+		_ = quote.Get()
+		runCount++
+	})
+
+	price.Set(2)
+	gate.Set(false)
+	if runCount != 2 {
+		t.Errorf("Expected the gate not to become a dependency, ran %d times", runCount)
+	}
+}
+
+func TestMemo_NilFunctionPanicsWithClearError(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	constructors := map[string]func(){
+		"Memo":          func() { Memo[int](s, nil) },
+		"MemoOr":        func() { MemoOr(s, nil, 0) },
+		"MemoWithGuard": func() { MemoWithGuard[int](s, nil, func() bool { return true }) },
+		"KeyedMemo":     func() { KeyedMemo[int, int](s, func() int { return 0 }, nil) },
+		"ExplicitMemo":  func() { ExplicitMemo[int](s, nil, nil) },
+	}
+	for name, create := range constructors {
+		if got := capturePanic(create); got != ErrNilMemoFunc {
+			t.Errorf("Expected %s to panic with ErrNilMemoFunc, got %v", name, got)
+		}
+	}
+}
+
+func TestMemo_StaysCachedAcrossSubscriberReruns(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	a := New(s, 1)
+	other := New(s, 1)
+	runCount := 0
+	doubled := Memo(s, func() int {
+		runCount++
+		return a.Get() * 2
+	})
+	Effect(s, func() {
+		_ = doubled.Get()
+		_ = other.Get()
+	})
+
+	other.Set(2)
+	other.Set(3)
+	if runCount != 1 {
+		t.Errorf("Expected memo to stay cached while its subscriber re-runs, ran %d times", runCount)
+	}
+}
+
+func TestKeyedMemo_RecomputesOnlyWhenKeyChanges(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	userID := New(s, 1)
+	theme := New(s, "light")
+	runCount := 0
+	profile := KeyedMemo(s, func() int { return userID.Get() / 10 }, func() string {
+		runCount++
+		return theme.Get()
+	})
+	Effect(s, func() { _ = profile.Get() })
+
+	theme.Set("dark")
+	userID.Set(2) // Same key, 2/10 == 1/10.
+	if runCount != 1 {
+		t.Errorf("Expected fn to run once while the key is unchanged, ran %d times", runCount)
+	}
+	if got := profile.Get(); got != "light" {
+		t.Errorf("Expected the cached value 'light', got %q", got)
+	}
+
+	userID.Set(20)
+	if runCount != 2 {
+		t.Errorf("Expected fn to rerun after the key changed, ran %d times", runCount)
+	}
+	if got := profile.Get(); got != "dark" {
+		t.Errorf("Expected 'dark' after the key changed, got %q", got)
+	}
+}
+
+func TestExplicitMemo_OnlyListedDepsTriggerRecomputation(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	price := New(s, 10)
+	quantity := New(s, 2)
+	discount := New(s, 0)
+	runCount := 0
+	total := ExplicitMemo(s, []Dependency{Dep(price), Dep(quantity)}, func() int {
+		runCount++
+		return price.Get()*quantity.Get() - discount.Get()
+	})
+	Effect(s, func() { _ = total.Get() })
+
+	discount.Set(5)
+	if runCount != 1 {
+		t.Errorf("Expected reads inside fn not to add dependencies, ran %d times", runCount)
+	}
+
+	quantity.Set(3)
+	if runCount != 2 {
+		t.Errorf("Expected a listed dependency to trigger recomputation, ran %d times", runCount)
+	}
+	if got := total.Get(); got != 25 {
+		t.Errorf("Expected 25, got %d", got)
+	}
+}
+
+func TestMemo_ComputedReportsFirstRun(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	a := New(s, 1)
+	doubled := Memo(s, func() int { return a.Get() * 2 })
+	computed := doubled.(interface{ Computed() bool })
+
+	if computed.Computed() {
+		t.Error("Expected Computed to be false before the first Get")
+	}
+	_ = doubled.Get()
+	if !computed.Computed() {
+		t.Error("Expected Computed to be true after the first Get")
+	}
+	a.Set(2)
+	if !computed.Computed() {
+		t.Error("Expected Computed to stay true once the memo went stale")
+	}
+}
+
+func TestDeferredMemo_NotifiesAfterSynchronousPropagation(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	a := New(s, 1)
+	doubled := DeferredMemo(s, func() int { return a.Get() * 2 })
+	plain := Memo(s, func() int { return a.Get() * 3 })
+	var order []string
+	Effect(s, func() {
+		order = append(order, fmt.Sprintf("deferred %d", doubled.Get()))
+	})
+	LayoutEffect(s, func() {
+		order = append(order, fmt.Sprintf("layout %d", plain.Get()))
+	})
+	Effect(s, func() {
+		order = append(order, fmt.Sprintf("passive %d", a.Get()))
+	})
+
+	order = nil
+	a.Set(2)
+	want := []string{"layout 6", "passive 2", "deferred 4"}
+	if !slices.Equal(order, want) {
+		t.Errorf("Expected %v before Set returned, got %v", want, order)
+	}
+}
+
+func TestMemo_PeekRecomputesWithoutSubscribing(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	base := New(s, 1)
+	doubled := Memo(s, func() int { return base.Get() * 2 })
+	runs := 0
+	Effect(s, func() {
+		_ = doubled.Peek()
+		runs++
+	})
+
+	base.Set(5)
+	if runs != 1 {
+		t.Errorf("Expected a peeked memo not to re-run the effect, ran %d times", runs)
+	}
+	if got := doubled.Peek(); got != 10 {
+		t.Errorf("Expected Peek to recompute the stale memo to 10, got %d", got)
+	}
+}
+
+func TestMemo_EqualRecomputationDoesNotNotify(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 2)
+	parity := Memo(s, func() int { return count.Get() % 2 })
+	label := Memo(s, func() string {
+		if parity.Get() == 0 {
+			return "even"
+		}
+		return "odd"
+	})
+	runs := 0
+	Effect(s, func() {
+		_ = label.Get()
+		runs++
+	})
+
+	count.Set(4)
+	count.Set(6)
+	if runs != 1 {
+		t.Errorf("Expected a parity that stays the same not to re-run the effect, ran %d times", runs)
+	}
+	count.Set(7)
+	if runs != 2 || label.Get() != "odd" {
+		t.Errorf("Expected a parity change to re-run the effect once, ran %d times with %q", runs, label.Get())
+	}
+}
+
+func TestMemo_WithEqualsComparesUncomparableValues(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	limit := New(s, 3)
+	evens := Memo(s, func() []int {
+		var out []int
+		for i := 0; i < limit.Get(); i += 2 {
+			out = append(out, i)
+		}
+		return out
+	}, WithEquals(slices.Equal[[]int]))
+	runs := 0
+	Effect(s, func() {
+		_ = evens.Get()
+		runs++
+	})
+
+	limit.Set(4)
+	if runs != 1 {
+		t.Errorf("Expected an equal slice not to re-run the effect, ran %d times", runs)
+	}
+	limit.Set(5)
+	if runs != 2 {
+		t.Errorf("Expected a different slice to re-run the effect, ran %d times", runs)
+	}
+}
+
+func TestDeferredMemo_EqualRecomputationDoesNotNotify(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	n := New(s, 2)
+	parity := DeferredMemo(s, func() int { return n.Get() % 2 })
+	runs := 0
+	Effect(s, func() {
+		_ = parity.Get()
+		runs++
+	})
+
+	n.Set(4)
+	if runs != 1 {
+		t.Errorf("Expected an equal recomputation not to re-run the effect, ran %d times", runs)
+	}
+	n.Set(5)
+	if runs != 2 {
+		t.Errorf("Expected a changed value to re-run the effect, ran %d times", runs)
+	}
+}
@@ -0,0 +1,36 @@
+package signals_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgarvarela24/signals-go/pkg/signals"
+	"github.com/edgarvarela24/signals-go/pkg/signals/signalstest"
+)
+
+func TestMemoTTL_RecomputesAfterExpiry(t *testing.T) {
+	clock := signalstest.NewFakeClock(time.Unix(0, 0))
+	eng := signals.Start(signals.WithClock(clock))
+	defer eng.Close()
+	s := eng.Scope()
+
+	runs := 0
+	stamp := signals.MemoTTL(s, func() int {
+		runs++
+		return runs
+	}, time.Minute, clock)
+
+	_ = stamp.Get()
+	clock.Advance(59 * time.Second)
+	if got := stamp.Get(); got != 1 {
+		t.Errorf("Expected the cached value before the TTL, got %d", got)
+	}
+
+	clock.Advance(time.Second)
+	if got := stamp.Get(); got != 2 {
+		t.Errorf("Expected a recomputation once the TTL elapsed, got %d", got)
+	}
+	if got := stamp.Get(); got != 2 {
+		t.Errorf("Expected the fresh value to be cached again, got %d", got)
+	}
+}
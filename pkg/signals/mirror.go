@@ -0,0 +1,114 @@
+package signals
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var _ Readonly[int] = (*AtomicMirror[int])(nil)
+
+// AtomicMirror reflects a value owned by existing atomic-based code, such as
+// a sync/atomic value, into the reactive graph. The atomic can't announce its
+// own writes, so the mirror picks them up when Refresh is called or, with
+// Poll, periodically. It implements Readonly[T].
+type AtomicMirror[T comparable] struct {
+	sig  *signal[T]
+	load func() T
+
+	mu    sync.Mutex
+	timer Timer
+	// polls counts the calls to Poll and stopPolling, so a tick of a poll
+	// loop that has since been replaced or stopped doesn't reschedule.
+	polls uint64
+}
+
+// MirrorAtomic creates a mirror of a, which can be any type with a Load
+// method, such as *atomic.Int32 or *atomic.Pointer.
+func MirrorAtomic[T comparable](s *Scope, a interface{ Load() T }) *AtomicMirror[T] {
+	m := &AtomicMirror[T]{
+		sig: &signal[T]{
+			scope:       s,
+			value:       a.Load(),
+			subscribers: make(map[computation]struct{}),
+			equals:      func(a, b T) bool { return a == b },
+		},
+		load: a.Load,
+	}
+	watchLeaks(s.engine, m.sig)
+	onScopeCleanup(s, m.stopPolling)
+	return m
+}
+
+// MirrorAtomicBool creates a mirror of a.
+func MirrorAtomicBool(s *Scope, a *atomic.Bool) *AtomicMirror[bool] {
+	return MirrorAtomic(s, a)
+}
+
+// MirrorAtomicInt64 creates a mirror of a.
+func MirrorAtomicInt64(s *Scope, a *atomic.Int64) *AtomicMirror[int64] {
+	return MirrorAtomic(s, a)
+}
+
+// Get returns the value as of the latest refresh, subscribing the active
+// computation.
+func (m *AtomicMirror[T]) Get() T {
+	return m.sig.Get()
+}
+
+func (m *AtomicMirror[T]) Peek() T {
+	return m.sig.Peek()
+}
+
+// Refresh loads the atomic, notifying subscribers if its value changed.
+func (m *AtomicMirror[T]) Refresh() {
+	m.sig.Set(m.load())
+}
+
+// Poll refreshes the mirror every interval, timed by the engine's clock,
+// until the scope is disposed. Calling it again replaces the interval.
+func (m *AtomicMirror[T]) Poll(interval time.Duration) {
+	clock := m.sig.scope.engine.clock
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.timer != nil {
+		m.timer.Stop()
+	}
+	m.polls++
+	poll := m.polls
+	var tick func()
+	tick = func() {
+		m.Refresh()
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if m.polls == poll {
+			m.timer = clock.AfterFunc(interval, tick)
+		}
+	}
+	m.timer = clock.AfterFunc(interval, tick)
+}
+
+func (m *AtomicMirror[T]) stopPolling() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.polls++
+	if m.timer != nil {
+		m.timer.Stop()
+		m.timer = nil
+	}
+}
+
+func (m *AtomicMirror[T]) subscriberSnapshot() []computation {
+	return m.sig.subscriberSnapshot()
+}
+
+// StoreAtomic keeps a, which can be any type with a Store method such as
+// *atomic.Bool, in step with r: it stores r's value now and after every
+// change. The returned stop detaches it; it is also detached when s is
+// disposed.
+func StoreAtomic[T any](s *Scope, r Readonly[T], a interface{ Store(T) }) (stop func()) {
+	stop = Effect(s, func() {
+		a.Store(r.Get())
+	})
+	return stop
+}
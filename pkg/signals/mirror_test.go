@@ -0,0 +1,117 @@
+package signals_test
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/edgarvarela24/signals-go/pkg/signals"
+	"github.com/edgarvarela24/signals-go/pkg/signals/signalstest"
+)
+
+func TestMirrorAtomic_RefreshPropagatesAtomicWrites(t *testing.T) {
+	eng := signals.Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	var ready atomic.Bool
+	mirror := signals.MirrorAtomicBool(s, &ready)
+	runs := 0
+	signals.Effect(s, func() {
+		_ = mirror.Get()
+		runs++
+	})
+
+	ready.Store(true)
+	mirror.Refresh()
+	mirror.Refresh()
+	if !mirror.Get() {
+		t.Error("Expected the mirror to reflect the atomic after Refresh")
+	}
+	if runs != 2 {
+		t.Errorf("Expected one re-run for the single change, ran %d times", runs)
+	}
+}
+
+func TestMirrorAtomic_PollUsesEngineClock(t *testing.T) {
+	clock := signalstest.NewFakeClock(time.Unix(0, 0))
+	eng := signals.Start(signals.WithClock(clock))
+	s := eng.Scope()
+
+	var hits atomic.Int64
+	mirror := signals.MirrorAtomicInt64(s, &hits)
+	mirror.Poll(time.Second)
+
+	hits.Store(3)
+	clock.Advance(500 * time.Millisecond)
+	if got := mirror.Get(); got != 0 {
+		t.Errorf("Expected no refresh before the interval, got %d", got)
+	}
+	clock.Advance(500 * time.Millisecond)
+	if got := mirror.Get(); got != 3 {
+		t.Errorf("Expected 3 after one interval, got %d", got)
+	}
+	hits.Store(5)
+	clock.Advance(time.Second)
+	if got := mirror.Get(); got != 5 {
+		t.Errorf("Expected 5 after the next interval, got %d", got)
+	}
+
+	eng.Close()
+	if n := clock.Pending(); n != 0 {
+		t.Errorf("Expected polling to stop on close, %d timers pending", n)
+	}
+}
+
+// hookedInt64 is an atomic whose next Load first calls onLoad.
+type hookedInt64 struct {
+	atomic.Int64
+	onLoad func()
+}
+
+func (h *hookedInt64) Load() int64 {
+	if fn := h.onLoad; fn != nil {
+		h.onLoad = nil
+		fn()
+	}
+	return h.Int64.Load()
+}
+
+func TestMirrorAtomic_PollFromRunningTickReplacesLoop(t *testing.T) {
+	clock := signalstest.NewFakeClock(time.Unix(0, 0))
+	eng := signals.Start(signals.WithClock(clock))
+	s := eng.Scope()
+
+	var hits hookedInt64
+	mirror := signals.MirrorAtomic[int64](s, &hits)
+	mirror.Poll(time.Second)
+	hits.onLoad = func() { mirror.Poll(2 * time.Second) }
+
+	clock.Advance(time.Second)
+	if n := clock.Pending(); n != 1 {
+		t.Errorf("Expected only the new poll loop to be scheduled, %d timers pending", n)
+	}
+
+	eng.Close()
+	if n := clock.Pending(); n != 0 {
+		t.Errorf("Expected polling to stop on close, %d timers pending", n)
+	}
+}
+
+func TestStoreAtomic_WritesSignalChangesBack(t *testing.T) {
+	eng := signals.Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	var limit atomic.Int64
+	src := signals.New[int64](s, 10)
+	signals.StoreAtomic(s, src, &limit)
+	if got := limit.Load(); got != 10 {
+		t.Errorf("Expected the initial value to be stored, got %d", got)
+	}
+
+	src.Set(20)
+	if got := limit.Load(); got != 20 {
+		t.Errorf("Expected 20, got %d", got)
+	}
+}
@@ -0,0 +1,75 @@
+package signals
+
+// namedSignal is the engine's view of a signal registered under a name.
+type namedSignal interface {
+	signalName() string
+	wasSubscribed() bool
+	writeVersion() uint64
+	// cloneInto registers a copy of the signal's current value on s.
+	cloneInto(s *Scope)
+}
+
+// NewNamed creates a signal registered with the engine under name, which
+// makes it visible to engine-wide diagnostics. Registering a second signal
+// under the same name replaces the first. The signal is unregistered when s
+// is disposed.
+func NewNamed[T any](s *Scope, name string, initial T) Signal[T] {
+	sig := &signal[T]{
+		scope:       s,
+		value:       initial,
+		subscribers: make(map[computation]struct{}),
+		name:        name,
+		equals:      comparableEquals[T](),
+	}
+	s.engine.register(sig)
+	watchLeaks(s.engine, sig)
+	onScopeCleanup(s, func() {
+		s.engine.unregister(sig)
+	})
+	return sig
+}
+
+// LookupNamed returns the signal registered on e under name, if there is one
+// and it holds values of type T.
+func LookupNamed[T any](e *Engine, name string) (Signal[T], bool) {
+	e.registryMu.Lock()
+	defer e.registryMu.Unlock()
+	sig, ok := e.registry[name].(*signal[T])
+	return sig, ok
+}
+
+func (s *signal[T]) signalName() string {
+	return s.name
+}
+
+func (s *signal[T]) wasSubscribed() bool {
+	return s.subscribed.Load()
+}
+
+func (s *signal[T]) writeVersion() uint64 {
+	return s.version.Load()
+}
+
+func (s *signal[T]) cloneInto(scope *Scope) {
+	s.mu.RLock()
+	value := s.value
+	s.mu.RUnlock()
+	NewNamed(scope, s.name, value)
+}
+
+func (e *Engine) register(n namedSignal) {
+	e.registryMu.Lock()
+	defer e.registryMu.Unlock()
+	if e.registry == nil {
+		e.registry = make(map[string]namedSignal)
+	}
+	e.registry[n.signalName()] = n
+}
+
+func (e *Engine) unregister(n namedSignal) {
+	e.registryMu.Lock()
+	defer e.registryMu.Unlock()
+	if e.registry[n.signalName()] == n {
+		delete(e.registry, n.signalName())
+	}
+}
@@ -0,0 +1,76 @@
+package signals
+
+import "context"
+
+// PendingWork describes work the engine has accepted but not yet finished.
+type PendingWork struct {
+	// Batching reports whether a batch is open.
+	Batching bool
+	// Queued is the number of computations waiting for a batch to flush.
+	Queued int
+	// Scheduled is the number of effect runs handed to a dispatcher, such as
+	// the one set by WithDispatchGoroutine, that have not yet completed.
+	Scheduled int
+}
+
+// Idle reports whether there is no pending work.
+func (p PendingWork) Idle() bool {
+	return !p.Batching && p.Queued == 0 && p.Scheduled == 0
+}
+
+// Pending returns a snapshot of the engine's pending work.
+func (e *Engine) Pending() PendingWork {
+	e.batchQueueMu.Lock()
+	defer e.batchQueueMu.Unlock()
+	return PendingWork{
+		Batching:  e.batchDepth > 0,
+		Queued:    len(e.batchQueue),
+		Scheduled: int(e.scheduled.Load()),
+	}
+}
+
+// WaitIdle blocks until the engine has no pending work, as reported by
+// Pending, or ctx is done, in which case it returns ctx's error. Effect runs
+// queued on a dispatcher count as pending until they complete, so with
+// WithDispatchGoroutine WaitIdle must not be called from the dispatcher's
+// own loop. Work started after WaitIdle returns is not waited for.
+func (e *Engine) WaitIdle(ctx context.Context) error {
+	for {
+		// Take the channel before checking, so progress made in between
+		// still wakes us.
+		progress := e.progressChan()
+		if e.Pending().Idle() {
+			return nil
+		}
+		select {
+		case <-progress:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (e *Engine) progressChan() <-chan struct{} {
+	e.progressMu.Lock()
+	defer e.progressMu.Unlock()
+	if e.progress == nil {
+		e.progress = make(chan struct{})
+	}
+	return e.progress
+}
+
+// signalProgress wakes WaitIdle callers to re-check for pending work.
+func (e *Engine) signalProgress() {
+	e.progressMu.Lock()
+	defer e.progressMu.Unlock()
+	if e.progress != nil {
+		close(e.progress)
+		e.progress = nil
+	}
+}
+
+// finishScheduled records the completion of a dispatched effect run.
+func (e *Engine) finishScheduled() {
+	e.scheduled.Add(-1)
+	e.signalProgress()
+}
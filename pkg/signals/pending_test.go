@@ -0,0 +1,87 @@
+package signals
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestEngine_PendingReportsBatchedAndScheduledWork(t *testing.T) {
+	var queue []func()
+	eng := Start(WithDispatchGoroutine(func(fn func()) {
+		queue = append(queue, fn)
+	}))
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 0)
+	Effect(s, func() {
+		_ = count.Get()
+	})
+
+	if p := eng.Pending(); !p.Idle() {
+		t.Fatalf("Expected a fresh engine to be idle, got %+v", p)
+	}
+
+	s.Batch(func() {
+		count.Set(1)
+		if p := eng.Pending(); !p.Batching || p.Queued != 1 {
+			t.Errorf("Expected an open batch with one queued computation, got %+v", p)
+		}
+	})
+
+	if p := eng.Pending(); p.Scheduled != 1 || p.Batching || p.Queued != 0 {
+		t.Errorf("Expected one scheduled effect after the flush, got %+v", p)
+	}
+
+	queue[0]()
+	if p := eng.Pending(); !p.Idle() {
+		t.Errorf("Expected the engine to be idle after the dispatched run, got %+v", p)
+	}
+}
+
+func TestEngine_WaitIdleReturnsAfterDispatchedWork(t *testing.T) {
+	runs := make(chan func(), 10)
+	go func() {
+		for fn := range runs {
+			time.Sleep(10 * time.Millisecond)
+			fn()
+		}
+	}()
+	eng := Start(WithDispatchGoroutine(func(fn func()) { runs <- fn }))
+	defer close(runs)
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 0)
+	var seen atomic.Int64
+	Effect(s, func() {
+		seen.Store(int64(count.Get()))
+	})
+
+	count.Set(1)
+	if err := eng.WaitIdle(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if got := seen.Load(); got != 1 {
+		t.Errorf("Expected the dispatched run to have completed, saw %d", got)
+	}
+}
+
+func TestEngine_WaitIdleStopsAtContextDeadline(t *testing.T) {
+	eng := Start(WithDispatchGoroutine(func(fn func()) {}))
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 0)
+	Effect(s, func() { _ = count.Get() })
+	count.Set(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := eng.WaitIdle(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected the deadline error while the run stays queued, got %v", err)
+	}
+}
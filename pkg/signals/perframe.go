@@ -0,0 +1,30 @@
+package signals
+
+// PerFrame returns a signal that follows src, changing at most once per turn
+// of the engine's scheduler. It copies src through an effect, and an effect
+// run waiting on the scheduler absorbs any further changes made before it
+// runs, so all the changes src goes through between two frames reach
+// subscribers as a single update to the latest value.
+//
+// Without a dispatcher, set by WithScheduler or WithDispatchGoroutine,
+// effects run as soon as src changes and PerFrame simply follows src.
+func PerFrame[T any](s *Scope, src Readonly[T]) Readonly[T] {
+	var initial T
+	Untrack(s, func() {
+		initial = src.Get()
+	})
+	out := New(s, initial)
+
+	started := false
+	Effect(s, func() {
+		v := src.Get()
+		if !started {
+			started = true
+			return
+		}
+		Untrack(s, func() {
+			out.Set(v)
+		})
+	})
+	return out
+}
@@ -0,0 +1,42 @@
+package signals
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPerFrame_EmitsLatestOncePerFrame(t *testing.T) {
+	var queue []func()
+	// frame runs everything queued, including work queued along the way.
+	frame := func() {
+		for len(queue) > 0 {
+			fn := queue[0]
+			queue = queue[1:]
+			fn()
+		}
+	}
+	eng := Start(WithDispatchGoroutine(func(fn func()) {
+		queue = append(queue, fn)
+	}))
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := New(s, 0)
+	framed := PerFrame(s, src)
+	var seen []int
+	Effect(s, func() {
+		seen = append(seen, framed.Get())
+	})
+
+	src.Set(1)
+	src.Set(2)
+	src.Set(3)
+	frame()
+	src.Set(4)
+	src.Set(5)
+	frame()
+
+	if want := []int{0, 3, 5}; !slices.Equal(seen, want) {
+		t.Errorf("Expected one update per frame %v, got %v", want, seen)
+	}
+}
@@ -0,0 +1,63 @@
+package signals
+
+import "sync"
+
+// Probe is a computation that only observes. Reads made inside Run subscribe
+// it exactly as they would a running effect or memo, and changes to those
+// sources are counted instead of re-running anything. It is meant for tests
+// of code that reads signals, such as custom Readonly implementations; see
+// signalstest.Capture.
+type Probe struct {
+	engine   *Engine
+	mu       sync.Mutex
+	sources  map[subscribable]struct{}
+	notified int
+}
+
+// NewProbe creates a probe that listens on e.
+func NewProbe(e *Engine) *Probe {
+	return &Probe{engine: e}
+}
+
+// Run runs fn with p as the calling goroutine's active listener. The
+// previous listener is restored even if fn panics.
+func (p *Probe) Run(fn func()) {
+	p.engine.withListener(p, fn)
+}
+
+// Notified returns how many times a source p is subscribed to has notified
+// it of a change.
+func (p *Probe) Notified() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.notified
+}
+
+// Stop unsubscribes p from every source it was subscribed to.
+func (p *Probe) Stop() {
+	p.mu.Lock()
+	sources := p.sources
+	p.sources = nil
+	p.mu.Unlock()
+	for s := range sources {
+		s.unsubscribe(p)
+	}
+}
+
+func (p *Probe) Kind() string { return "probe" }
+func (p *Probe) Name() string { return "" }
+
+func (p *Probe) notify() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.notified++
+}
+
+func (p *Probe) addSource(s subscribable) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.sources == nil {
+		p.sources = make(map[subscribable]struct{})
+	}
+	p.sources[s] = struct{}{}
+}
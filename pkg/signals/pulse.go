@@ -0,0 +1,59 @@
+package signals
+
+import (
+	"sync"
+	"time"
+)
+
+// PulseSignal is a bool signal that Trigger turns on for a while, for
+// transient states such as a "saved!" indicator. It can also be written
+// directly; a direct write doesn't affect a running pulse's timer.
+type PulseSignal struct {
+	Signal[bool]
+	clock Clock
+	d     time.Duration
+
+	mu    sync.Mutex
+	timer Timer
+}
+
+// Pulse creates a pulse signal, initially false, that stays true for d
+// after each Trigger, timed by clock. Its timer is stopped when s is
+// disposed.
+func Pulse(s *Scope, d time.Duration, clock Clock) *PulseSignal {
+	p := &PulseSignal{Signal: New(s, false), clock: clock, d: d}
+	onScopeCleanup(s, func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		if p.timer != nil {
+			p.timer.Stop()
+			p.timer = nil
+		}
+	})
+	return p
+}
+
+// Trigger sets the signal true and schedules it to reset to false after d.
+// Triggering again before then restarts the wait.
+func (p *PulseSignal) Trigger() {
+	p.mu.Lock()
+	if p.timer != nil {
+		p.timer.Stop()
+	}
+	var timer Timer
+	timer = p.clock.AfterFunc(p.d, func() {
+		p.mu.Lock()
+		if p.timer != timer {
+			p.mu.Unlock()
+			return
+		}
+		p.timer = nil
+		p.mu.Unlock()
+		p.Set(false)
+	})
+	p.timer = timer
+	p.mu.Unlock()
+
+	// Writing true while on already is dropped as an equal write.
+	p.Set(true)
+}
@@ -0,0 +1,39 @@
+package signals_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgarvarela24/signals-go/pkg/signals"
+	"github.com/edgarvarela24/signals-go/pkg/signals/signalstest"
+)
+
+func TestPulse_ResetsAfterDuration(t *testing.T) {
+	clock := signalstest.NewFakeClock(time.Unix(0, 0))
+	eng := signals.Start(signals.WithClock(clock))
+	s := eng.Scope()
+
+	saved := signals.Pulse(s, 2*time.Second, clock)
+	saved.Trigger()
+	if !saved.Get() {
+		t.Fatal("Expected the pulse to be on after Trigger")
+	}
+
+	clock.Advance(1500 * time.Millisecond)
+	saved.Trigger() // Restarts the wait.
+	clock.Advance(1500 * time.Millisecond)
+	if !saved.Get() {
+		t.Error("Expected a repeated Trigger to extend the pulse")
+	}
+
+	clock.Advance(500 * time.Millisecond)
+	if saved.Get() {
+		t.Error("Expected the pulse to reset 2s after the last Trigger")
+	}
+
+	saved.Trigger()
+	eng.Close()
+	if n := clock.Pending(); n != 0 {
+		t.Errorf("Expected the timer to stop on dispose, %d pending", n)
+	}
+}
@@ -0,0 +1,147 @@
+package signals
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// RateLimitOption configures RateLimited.
+type RateLimitOption func(*rateLimitConfig)
+
+type rateLimitConfig struct {
+	queue bool
+}
+
+// QueueExcess makes RateLimited queue changes beyond the limit and emit them
+// in order as the window allows, instead of dropping them.
+func QueueExcess() RateLimitOption {
+	return func(c *rateLimitConfig) {
+		c.queue = true
+	}
+}
+
+// ErrInvalidRateLimit is the panic value raised when RateLimited is given a
+// max or per that isn't positive.
+var ErrInvalidRateLimit = errors.New("signals: rate limit max and per must be positive")
+
+// RateLimited returns a signal that follows src but propagates at most max
+// changes in any sliding window of length per. By default changes beyond the
+// limit are dropped; with QueueExcess they are delivered later, in order.
+// Time is read from the engine's clock. max and per must be positive.
+func RateLimited[T any](s *Scope, src Readonly[T], max int, per time.Duration, opts ...RateLimitOption) Readonly[T] {
+	if max <= 0 || per <= 0 {
+		panic(ErrInvalidRateLimit)
+	}
+	var cfg rateLimitConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	var initial T
+	Untrack(s, func() {
+		initial = src.Get()
+	})
+	r := &rateLimiter[T]{
+		out:   New(s, initial),
+		clock: s.engine.clock,
+		max:   max,
+		per:   per,
+		queue: cfg.queue,
+	}
+
+	started := false
+	stop := Effect(s, func() {
+		v := src.Get()
+		if !started {
+			started = true
+			return
+		}
+		r.offer(v)
+	})
+	onScopeCleanup(s, func() {
+		stop()
+		r.stop()
+	})
+	return r.out
+}
+
+type rateLimiter[T any] struct {
+	out   Signal[T]
+	clock Clock
+	max   int
+	per   time.Duration
+	queue bool
+
+	mu      sync.Mutex
+	sent    []time.Time // emission times still inside the window
+	pending []T
+	timer   Timer
+}
+
+func (r *rateLimiter[T]) offer(v T) {
+	r.mu.Lock()
+	now := r.clock.Now()
+	r.prune(now)
+	if len(r.pending) == 0 && len(r.sent) < r.max {
+		r.sent = append(r.sent, now)
+		r.mu.Unlock()
+		r.out.Set(v)
+		return
+	}
+	if r.queue {
+		r.pending = append(r.pending, v)
+		r.schedule(now)
+	}
+	r.mu.Unlock()
+}
+
+// drain emits as many queued values as the window allows.
+func (r *rateLimiter[T]) drain() {
+	r.mu.Lock()
+	r.timer = nil
+	now := r.clock.Now()
+	r.prune(now)
+	var emit []T
+	for len(r.pending) > 0 && len(r.sent) < r.max {
+		emit = append(emit, r.pending[0])
+		r.pending = r.pending[1:]
+		r.sent = append(r.sent, now)
+	}
+	if len(r.pending) > 0 {
+		r.schedule(now)
+	}
+	r.mu.Unlock()
+
+	for _, v := range emit {
+		r.out.Set(v)
+	}
+}
+
+// prune forgets emissions that have left the window. Callers must hold r.mu.
+func (r *rateLimiter[T]) prune(now time.Time) {
+	i := 0
+	for i < len(r.sent) && !now.Before(r.sent[i].Add(r.per)) {
+		i++
+	}
+	r.sent = r.sent[i:]
+}
+
+// schedule arranges for drain to run when the oldest emission leaves the
+// window. Callers must hold r.mu.
+func (r *rateLimiter[T]) schedule(now time.Time) {
+	if r.timer != nil || len(r.sent) == 0 {
+		return
+	}
+	r.timer = r.clock.AfterFunc(r.sent[0].Add(r.per).Sub(now), r.drain)
+}
+
+func (r *rateLimiter[T]) stop() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.timer != nil {
+		r.timer.Stop()
+		r.timer = nil
+	}
+	r.pending = nil
+}
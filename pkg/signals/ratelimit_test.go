@@ -0,0 +1,117 @@
+package signals_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/edgarvarela24/signals-go/pkg/signals"
+	"github.com/edgarvarela24/signals-go/pkg/signals/signalstest"
+)
+
+func TestRateLimited_DropsExcess(t *testing.T) {
+	clock := signalstest.NewFakeClock(time.Time{})
+	eng := signals.Start(signals.WithClock(clock))
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := signals.New(s, 0)
+	limited := signals.RateLimited(s, src, 2, time.Second)
+
+	var seen []int
+	signals.Effect(s, func() {
+		seen = append(seen, limited.Get())
+	})
+
+	for v := 1; v <= 4; v++ {
+		src.Set(v)
+	}
+	if want := []int{0, 1, 2}; !slices.Equal(seen, want) {
+		t.Fatalf("Expected %v within the first window, got %v", want, seen)
+	}
+
+	clock.Advance(time.Second)
+	if want := []int{0, 1, 2}; !slices.Equal(seen, want) {
+		t.Fatalf("Expected dropped values never to be delivered, got %v", seen)
+	}
+
+	src.Set(5)
+	if want := []int{0, 1, 2, 5}; !slices.Equal(seen, want) {
+		t.Errorf("Expected a new window to allow 5 through, got %v", seen)
+	}
+}
+
+func TestRateLimited_QueuesExcess(t *testing.T) {
+	clock := signalstest.NewFakeClock(time.Time{})
+	eng := signals.Start(signals.WithClock(clock))
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := signals.New(s, 0)
+	limited := signals.RateLimited(s, src, 2, time.Second, signals.QueueExcess())
+
+	var seen []int
+	signals.Effect(s, func() {
+		seen = append(seen, limited.Get())
+	})
+
+	for v := 1; v <= 5; v++ {
+		src.Set(v)
+	}
+	if want := []int{0, 1, 2}; !slices.Equal(seen, want) {
+		t.Fatalf("Expected %v within the first window, got %v", want, seen)
+	}
+
+	clock.Advance(time.Second)
+	if want := []int{0, 1, 2, 3, 4}; !slices.Equal(seen, want) {
+		t.Fatalf("Expected the next two queued values after one window, got %v", seen)
+	}
+
+	clock.Advance(time.Second)
+	if want := []int{0, 1, 2, 3, 4, 5}; !slices.Equal(seen, want) {
+		t.Errorf("Expected the last queued value after two windows, got %v", seen)
+	}
+}
+
+func TestRateLimited_SlidingWindow(t *testing.T) {
+	clock := signalstest.NewFakeClock(time.Time{})
+	eng := signals.Start(signals.WithClock(clock))
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := signals.New(s, 0)
+	limited := signals.RateLimited(s, src, 2, time.Second)
+
+	src.Set(1)
+	clock.Advance(600 * time.Millisecond)
+	src.Set(2)
+	clock.Advance(600 * time.Millisecond)
+
+	// The first emission has left the window but the second hasn't.
+	src.Set(3)
+	src.Set(4)
+	if val := limited.Get(); val != 3 {
+		t.Errorf("Expected only one more emission in the sliding window, got %d", val)
+	}
+}
+
+func TestRateLimited_RejectsNonPositiveLimits(t *testing.T) {
+	eng := signals.Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := signals.New(s, 0)
+	for _, limit := range []struct {
+		max int
+		per time.Duration
+	}{{0, time.Second}, {1, 0}} {
+		func() {
+			defer func() {
+				if got := recover(); got != signals.ErrInvalidRateLimit {
+					t.Errorf("Expected max %d per %v to panic with ErrInvalidRateLimit, got %v", limit.max, limit.per, got)
+				}
+			}()
+			signals.RateLimited(s, src, limit.max, limit.per)
+		}()
+	}
+}
@@ -0,0 +1,14 @@
+package signals
+
+// Ratio returns a computed value holding num / den, or ifZero while den is
+// zero. Pass 0 or math.NaN() for ifZero depending on how the caller wants
+// an undefined ratio to read.
+func Ratio(s *Scope, num, den Readonly[float64], ifZero float64) Readonly[float64] {
+	return Memo(s, func() float64 {
+		d := den.Get()
+		if d == 0 {
+			return ifZero
+		}
+		return num.Get() / d
+	})
+}
@@ -0,0 +1,33 @@
+package signals
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRatio_DividesAndGuardsZero(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	hits := New(s, 3.0)
+	total := New(s, 0.0)
+	rate := Ratio(s, hits, total, math.NaN())
+	zeroRate := Ratio(s, hits, total, 0)
+
+	if got := rate.Get(); !math.IsNaN(got) {
+		t.Errorf("Expected NaN for a zero denominator, got %v", got)
+	}
+	if got := zeroRate.Get(); got != 0 {
+		t.Errorf("Expected 0 for a zero denominator, got %v", got)
+	}
+
+	total.Set(4)
+	if got := rate.Get(); got != 0.75 {
+		t.Errorf("Expected 0.75, got %v", got)
+	}
+	hits.Set(2)
+	if got := rate.Get(); got != 0.5 {
+		t.Errorf("Expected 0.5 after the numerator changed, got %v", got)
+	}
+}
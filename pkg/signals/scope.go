@@ -1,58 +1,282 @@
 package signals
 
-import "sync/atomic"
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
 
 // Scope represents the lifetime of a reactive computation.
 type Scope struct {
 	isLive  atomic.Bool
 	engine  *Engine
-	cleanup []func()
+	cleanup []cleanupEntry // guarded by cleanupMu
+	// onDispose holds hooks that run once, after every cleanup. Guarded by
+	// cleanupMu.
+	onDispose []func()
+	cleanupMu sync.Mutex
+	// parent is the scope this one was derived from, or nil for a root.
+	parent *Scope
+
+	values   map[contextKey]any
+	valuesMu sync.Mutex
+}
+
+type cleanupEntry struct {
+	fn       func()
+	priority int
+	// key, if set, identifies the entry for removeCleanup.
+	key any
+	// halt, if set, is called by an asynchronous Dispose before it returns,
+	// to stop the effect behind fn reacting while fn waits for the worker.
+	halt func()
+}
+
+// Child returns a new scope on the same engine whose parent is s. The child
+// is disposed along with s, and can be disposed earlier on its own.
+func (s *Scope) Child() *Scope {
+	c := &Scope{engine: s.engine, parent: s}
+	c.isLive.Store(true)
+	s.addCleanup(cleanupEntry{fn: c.disposeNow, key: c, halt: c.halt})
+	return c
+}
+
+// addCleanup registers c to run when s is disposed.
+func (s *Scope) addCleanup(c cleanupEntry) {
+	s.cleanupMu.Lock()
+	defer s.cleanupMu.Unlock()
+	s.cleanup = append(s.cleanup, c)
 }
 
+// removeCleanup drops the cleanup registered under key, if any.
+func (s *Scope) removeCleanup(key any) {
+	s.cleanupMu.Lock()
+	defer s.cleanupMu.Unlock()
+	s.cleanup = slices.DeleteFunc(s.cleanup, func(c cleanupEntry) bool {
+		return c.key == key
+	})
+}
+
+// Batch runs fn with notifications deferred until it returns. Every
+// computation invalidated inside fn is queued and re-run once when the batch
+// ends, no matter how many of its dependencies were written. Batches nest:
+// a Batch inside another only queues, and the queue is flushed when the
+// outermost one ends.
+//
+// An effect created inside fn runs its initial pass immediately, observing
+// whatever values have been written so far in the batch. If any of its
+// dependencies are written after it was created, it is queued like any other
+// subscriber and re-runs once when the batch is flushed.
 func (s *Scope) Batch(fn func()) {
-	// For now, just handles disposed state
 	if !s.isLive.Load() {
 		return
 	}
 
 	// Register batch with engine
-	s.engine.isBatching.Store(true)
+	s.engine.batchQueueMu.Lock()
+	s.engine.batchDepth++
+	s.engine.batchQueueMu.Unlock()
 
-	// Ensure we always end the batch and flush the queue
+	// Ensure we always end the batch, and flush the queue if it was the
+	// outermost one
 	defer func() {
 		s.engine.batchQueueMu.Lock()
+		s.engine.batchDepth--
+		if s.engine.batchDepth > 0 {
+			s.engine.batchQueueMu.Unlock()
+			return
+		}
 		// Copy the queue to avoid holding the lock while notifying
 		queue := make([]computation, 0, len(s.engine.batchQueue))
 		for sub := range s.engine.batchQueue {
 			queue = append(queue, sub)
 		}
+		clear(s.engine.batchQueue)
 		s.engine.batchQueueMu.Unlock()
 
 		// Notify subscribers
-		for _, sub := range queue {
-			sub.notify()
-		}
+		s.engine.propagate(queue)
+		s.engine.signalProgress()
 	}()
 
 	fn()
 }
 
+// BatchAwait runs fn as a batch and returns a channel that is closed once
+// every reaction to it has finished. Without a dispatcher that is already
+// the case when BatchAwait returns. With WithDispatchGoroutine, the channel
+// closes on the consumer's loop after the effect runs queued by the flush,
+// and any runs they queue in turn, have completed.
+func BatchAwait(s *Scope, fn func()) <-chan struct{} {
+	done := make(chan struct{})
+	s.Batch(fn)
+
+	e := s.engine
+	if e.dispatch == nil {
+		close(done)
+		return done
+	}
+	// The dispatcher runs work in the order received, so this runs after
+	// everything the flush queued. Requeue while later runs are pending.
+	var await func()
+	await = func() {
+		if e.scheduled.Load() > 0 {
+			e.dispatch(await)
+			return
+		}
+		close(done)
+	}
+	e.dispatch(await)
+	return done
+}
+
+// Dispose runs s's cleanups and OnDispose hooks. With WithAsyncDispose they
+// run on the engine's disposal worker instead, and Dispose returns at once,
+// having already stopped the effects of s and its descendants reacting to
+// changes.
 func (s *Scope) Dispose() {
-	if !s.isLive.Swap(false) {
+	if !s.engine.asyncDispose {
+		s.disposeNow()
 		return
 	}
+	run := s.detach()
+	if run == nil {
+		return
+	}
+	s.halt()
+	if !s.engine.disposeAsync(run) {
+		run()
+	}
+}
 
-	// Run cleanup functions in reverse order
-	for i := len(s.cleanup) - 1; i >= 0; i-- {
-		s.cleanup[i]()
+// halt stops the effects of s and its descendants reacting to changes,
+// leaving their cleanups for s's disposal to run.
+func (s *Scope) halt() {
+	s.cleanupMu.Lock()
+	var halts []func()
+	for _, c := range s.cleanup {
+		if c.halt != nil {
+			halts = append(halts, c.halt)
+		}
 	}
+	s.cleanupMu.Unlock()
+	for _, fn := range halts {
+		fn()
+	}
+}
+
+// disposeNow disposes s inline. Child scopes are disposed this way along
+// with their parent, so a scope's cleanups never outlive its OnDispose hooks.
+func (s *Scope) disposeNow() {
+	if run := s.detach(); run != nil {
+		run()
+	}
+}
+
+// detach marks s disposed and unlinks it from its parent, returning the
+// function that runs its cleanups and hooks, or nil if s was already
+// disposed.
+func (s *Scope) detach() func() {
+	if !s.isLive.Swap(false) {
+		return nil
+	}
+	if s.parent != nil {
+		s.parent.removeCleanup(s)
+	}
+	return s.runCleanup
+}
+
+func (s *Scope) runCleanup() {
+	s.cleanupMu.Lock()
+	order := s.cleanup
 	s.cleanup = nil // Allow GC
+	s.cleanupMu.Unlock()
+
+	// Run cleanup functions by descending priority, and in reverse
+	// registration order within the same priority.
+	slices.Reverse(order)
+	slices.SortStableFunc(order, func(a, b cleanupEntry) int {
+		return cmp.Compare(b.priority, a.priority)
+	})
+	for _, c := range order {
+		c.fn()
+	}
+
+	s.cleanupMu.Lock()
+	hooks := s.onDispose
+	s.onDispose = nil
+	s.cleanupMu.Unlock()
+	for _, fn := range hooks {
+		fn()
+	}
 }
 
-func New[T any](s *Scope, initial T) Signal[T] {
-	return &signal[T]{
+// OnDispose registers a hook that runs exactly once when s is disposed,
+// after every OnCleanup and OnCleanupPriority callback regardless of their
+// priority or when they were registered. Hooks run in registration order.
+// If s is already disposed, fn runs immediately.
+func OnDispose(s *Scope, fn func()) {
+	s.cleanupMu.Lock()
+	if !s.isLive.Load() {
+		s.cleanupMu.Unlock()
+		fn()
+		return
+	}
+	s.onDispose = append(s.onDispose, fn)
+	s.cleanupMu.Unlock()
+}
+
+// New creates a signal holding initial. For comparable types, a Set or
+// Update that leaves the value equal to the current one does not notify
+// subscribers; WithEquals replaces that comparison.
+func New[T any](s *Scope, initial T, opts ...SignalOption[T]) Signal[T] {
+	sig := &signal[T]{
 		scope:       s,
 		value:       initial,
 		subscribers: make(map[computation]struct{}),
+		equals:      comparableEquals[T](),
+	}
+	for _, opt := range opts {
+		opt(sig)
+	}
+	watchLeaks(s.engine, sig)
+	return sig
+}
+
+// SignalOption configures a signal created with New.
+type SignalOption[T any] func(*signal[T])
+
+// WithEquals sets the function used to decide whether a write, or a memo's
+// recomputation, leaves the value unchanged, in which case subscribers are
+// not notified. Use it for types that aren't comparable, such as slices, or
+// to compare by something other than ==. A nil eq makes every change notify.
+func WithEquals[T any](eq func(a, b T) bool) SignalOption[T] {
+	return func(s *signal[T]) {
+		s.equals = eq
+	}
+}
+
+// ErrNilNormalizeFunc is the panic value raised when NewNormalized is given a
+// nil normalize function.
+var ErrNilNormalizeFunc = errors.New("signals: normalize function must not be nil")
+
+// NewNormalized creates a signal that passes every written value through
+// normalize before storing it, so the stored value is always canonical.
+// For comparable types, a write that normalizes to the current value does
+// not notify subscribers.
+func NewNormalized[T any](s *Scope, initial T, normalize func(T) T) Signal[T] {
+	if normalize == nil {
+		panic(ErrNilNormalizeFunc)
+	}
+	sig := &signal[T]{
+		scope:       s,
+		value:       normalize(initial),
+		subscribers: make(map[computation]struct{}),
+		normalize:   normalize,
+		equals:      comparableEquals[T](),
 	}
+	watchLeaks(s.engine, sig)
+	return sig
 }
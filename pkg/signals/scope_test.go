@@ -1,6 +1,13 @@
 package signals
 
-import "testing"
+import (
+	"math"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
 
 func TestSignal_New(t *testing.T) {
 	eng := Start()
@@ -13,3 +20,345 @@ func TestSignal_New(t *testing.T) {
 		t.Fatal("Expected non-nil Signal")
 	}
 }
+
+func TestScope_DisposeRunsCleanupsByPriority(t *testing.T) {
+	eng := Start()
+	s := eng.Scope()
+
+	var order []string
+	record := func(name string) func() {
+		return func() { order = append(order, name) }
+	}
+
+	OnCleanup(s, record("default-1"))
+	OnCleanupPriority(s, record("close-db"), -10)
+	OnCleanupPriority(s, record("flush-cache-1"), 10)
+	OnCleanup(s, record("default-2"))
+	OnCleanupPriority(s, record("flush-cache-2"), 10)
+	OnCleanupPriority(s, record("close-log"), -10)
+
+	eng.Close()
+
+	want := []string{
+		"flush-cache-2", "flush-cache-1",
+		"default-2", "default-1",
+		"close-log", "close-db",
+	}
+	if !slices.Equal(order, want) {
+		t.Errorf("Expected cleanup order %v, got %v", want, order)
+	}
+}
+
+func TestScope_DisposeOrdersExtremePriorities(t *testing.T) {
+	eng := Start()
+	s := eng.Scope()
+
+	var order []string
+	OnCleanupPriority(s, func() { order = append(order, "min") }, math.MinInt)
+	OnCleanupPriority(s, func() { order = append(order, "max") }, math.MaxInt)
+	OnCleanupPriority(s, func() { order = append(order, "one") }, 1)
+	eng.Close()
+
+	if want := []string{"max", "one", "min"}; !slices.Equal(order, want) {
+		t.Errorf("Expected cleanup order %v, got %v", want, order)
+	}
+}
+
+func TestScope_OnDisposeRunsAfterAllCleanups(t *testing.T) {
+	eng := Start()
+	s := eng.Scope()
+
+	var order []string
+	OnCleanup(s, func() { order = append(order, "cleanup-1") })
+	OnDispose(s, func() { order = append(order, "dispose-1") })
+	OnCleanupPriority(s, func() { order = append(order, "cleanup-low") }, -100)
+	OnDispose(s, func() { order = append(order, "dispose-2") })
+	OnCleanup(s, func() { order = append(order, "cleanup-2") })
+
+	eng.Close()
+	s.Dispose()
+
+	want := []string{"cleanup-2", "cleanup-1", "cleanup-low", "dispose-1", "dispose-2"}
+	if !slices.Equal(order, want) {
+		t.Errorf("Expected order %v, got %v", want, order)
+	}
+}
+
+func TestScope_OnDisposeAfterDisposeRunsImmediately(t *testing.T) {
+	eng := Start()
+	s := eng.Scope()
+	eng.Close()
+
+	ran := 0
+	OnDispose(s, func() { ran++ })
+	if ran != 1 {
+		t.Errorf("Expected hook on a disposed scope to run immediately once, ran %d times", ran)
+	}
+}
+
+func TestScope_BatchFlushWithResubscribingEffects(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	a := New(s, 0)
+	b := New(s, 0)
+	runCount := 0
+	Effect(s, func() {
+		_ = a.Get()
+		_ = b.Get()
+		runCount++
+	})
+
+	s.Batch(func() {
+		a.Set(1)
+		b.Set(1)
+	})
+	if runCount != 2 {
+		t.Errorf("Expected one re-run after the batch, ran %d times", runCount)
+	}
+}
+
+func TestScope_ChildIsDisposedWithParent(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	parent := eng.Scope().Child()
+	child := parent.Child()
+
+	var order []string
+	OnCleanup(child, func() { order = append(order, "child") })
+	OnCleanup(parent, func() { order = append(order, "parent") })
+
+	parent.Dispose()
+	if want := []string{"parent", "child"}; !slices.Equal(order, want) {
+		t.Errorf("Expected cleanups %v, got %v", want, order)
+	}
+}
+
+func TestScope_NestedBatchFlushesOnceAtOutermostEnd(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	a := New(s, 0)
+	b := New(s, 0)
+	var seen [][2]int
+	Effect(s, func() {
+		seen = append(seen, [2]int{a.Get(), b.Get()})
+	})
+
+	s.Batch(func() {
+		a.Set(1)
+		a.Set(2)
+		s.Batch(func() {
+			b.Set(1)
+			b.Set(2)
+			b.Set(3)
+		})
+		if len(seen) != 1 {
+			t.Errorf("Expected the inner batch not to flush, saw %v", seen)
+		}
+		a.Set(3)
+	})
+
+	if want := [][2]int{{0, 0}, {3, 3}}; !slices.Equal(seen, want) {
+		t.Errorf("Expected one run after the outer batch, saw %v", seen)
+	}
+	if p := eng.Pending(); !p.Idle() {
+		t.Errorf("Expected no batch to be left open, got %+v", p)
+	}
+}
+
+func TestBatchAwait_ClosesAfterDispatchedEffects(t *testing.T) {
+	var queue []func()
+	eng := Start(WithDispatchGoroutine(func(fn func()) {
+		queue = append(queue, fn)
+	}))
+	defer eng.Close()
+	s := eng.Scope()
+
+	a := New(s, 0)
+	b := New(s, 0)
+	var order []string
+	Effect(s, func() {
+		b.Set(a.Get())
+		order = append(order, "a")
+	})
+	Effect(s, func() {
+		_ = b.Get()
+		order = append(order, "b")
+	})
+	order = nil
+
+	done := BatchAwait(s, func() {
+		a.Set(1)
+	})
+	for len(queue) > 0 {
+		select {
+		case <-done:
+			t.Fatalf("Expected the channel to stay open until effects ran, ran %v", order)
+		default:
+		}
+		fn := queue[0]
+		queue = queue[1:]
+		fn()
+	}
+
+	select {
+	case <-done:
+	default:
+		t.Fatal("Expected the channel to close once the loop drained")
+	}
+	if want := []string{"a", "b"}; !slices.Equal(order, want) {
+		t.Errorf("Expected both effects to run before the channel closed, got %v", order)
+	}
+}
+
+func TestBatchAwait_ClosesImmediatelyWithoutDispatcher(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	select {
+	case <-BatchAwait(s, func() {}):
+	default:
+		t.Error("Expected the channel to be closed on return")
+	}
+}
+
+func TestScope_AsyncDisposeRunsEveryCleanup(t *testing.T) {
+	eng := Start(WithAsyncDispose())
+	s := eng.Scope().Child()
+
+	const n = 5000
+	var ran atomic.Int64
+	for range n {
+		OnCleanup(s, func() { ran.Add(1) })
+	}
+	// Cleanups run in reverse, so this one holds up all the others.
+	release := make(chan struct{})
+	OnCleanup(s, func() { <-release })
+
+	s.Dispose()
+	if got := ran.Load(); got != 0 {
+		t.Fatalf("Expected Dispose to return before cleanups run, %d already ran", got)
+	}
+	close(release)
+	if err := eng.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if got := ran.Load(); got != n {
+		t.Errorf("Expected all %d cleanups to have run by Close, got %d", n, got)
+	}
+}
+
+func TestScope_AsyncDisposeStopsEffectsAtOnce(t *testing.T) {
+	eng := Start(WithAsyncDispose())
+	defer eng.Close()
+	child := eng.Scope().Child()
+	grandchild := child.Child()
+
+	a := New(eng.Scope(), 0)
+	var runs atomic.Int64
+	Effect(child, func() {
+		_ = a.Get()
+		runs.Add(1)
+	})
+	EffectWithHandle(grandchild, func() {
+		_ = a.Get()
+		runs.Add(1)
+	})
+	// Holds the worker up, so the effects' cleanups haven't run yet.
+	release := make(chan struct{})
+	OnCleanup(child, func() { <-release })
+	defer close(release)
+
+	child.Dispose()
+	a.Set(1)
+	if got := runs.Load(); got != 2 {
+		t.Errorf("Expected effects of a disposed scope not to re-run, ran %d times", got)
+	}
+}
+
+func TestScope_AsyncDisposeKeepsHookOrder(t *testing.T) {
+	eng := Start(WithAsyncDispose())
+	defer eng.Close()
+	parent := eng.Scope().Child()
+	child := parent.Child()
+
+	var mu sync.Mutex
+	var order []string
+	record := func(s string) func() {
+		return func() {
+			mu.Lock()
+			defer mu.Unlock()
+			order = append(order, s)
+		}
+	}
+	OnCleanup(child, record("child"))
+	OnDispose(parent, record("parent hook"))
+	done := make(chan struct{})
+	OnDispose(parent, func() { close(done) })
+
+	parent.Dispose()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expected the async disposal to finish")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if want := []string{"child", "parent hook"}; !slices.Equal(order, want) {
+		t.Errorf("Expected %v, got %v", want, order)
+	}
+}
+
+func TestScope_DisposeInsideBatchStopsQueuedEffect(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 0)
+	child := s.Child()
+	runs := 0
+	Effect(child, func() {
+		_ = count.Get()
+		runs++
+	})
+
+	s.Batch(func() {
+		count.Set(1)
+		child.Dispose()
+	})
+	count.Set(2)
+	if runs != 1 {
+		t.Errorf("Expected the disposed effect not to run again, ran %d times", runs)
+	}
+}
+
+func TestScope_AsyncDisposeConcurrentWithClose(t *testing.T) {
+	eng := Start(WithAsyncDispose())
+	children := make([]*Scope, 64)
+	var ran atomic.Int64
+	for i := range children {
+		children[i] = eng.Scope().Child()
+		OnCleanup(children[i], func() { ran.Add(1) })
+	}
+
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for _, c := range children {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			c.Dispose()
+		}()
+	}
+	close(start)
+	eng.Close()
+	wg.Wait()
+	if got := ran.Load(); got != int64(len(children)) {
+		t.Errorf("Expected every cleanup to run once, got %d", got)
+	}
+}
@@ -0,0 +1,82 @@
+package signals
+
+// SetChange describes how a set differs from its previous value. Elements
+// appear in no particular order.
+type SetChange[K comparable] struct {
+	Added   []K
+	Removed []K
+}
+
+// SetDiff returns a signal describing, on every change of src, which
+// elements were added to and removed from the set since its previous value.
+// The initial value treats every element of src as added.
+func SetDiff[K comparable](s *Scope, src Readonly[map[K]struct{}]) Readonly[SetChange[K]] {
+	var out Signal[SetChange[K]]
+	prev := map[K]struct{}{}
+
+	Effect(s, func() {
+		next := src.Get()
+		var change SetChange[K]
+		for k := range next {
+			if _, ok := prev[k]; !ok {
+				change.Added = append(change.Added, k)
+			}
+		}
+		for k := range prev {
+			if _, ok := next[k]; !ok {
+				change.Removed = append(change.Removed, k)
+			}
+		}
+
+		// Keep a private copy so later in-place mutation of the source map
+		// can't corrupt the next diff.
+		prev = make(map[K]struct{}, len(next))
+		for k := range next {
+			prev[k] = struct{}{}
+		}
+
+		if out == nil {
+			out = New(s, change)
+			return
+		}
+		out.Set(change)
+	})
+	return out
+}
+
+// ChangedKeys returns a signal holding, on every change of src, the keys
+// that were added, removed, or whose value changed according to equal since
+// src's previous value, in no particular order. The initial value lists
+// every key of src.
+func ChangedKeys[K comparable, V any](s *Scope, src Readonly[map[K]V], equal func(a, b V) bool) Readonly[[]K] {
+	var out Signal[[]K]
+	prev := map[K]V{}
+
+	Effect(s, func() {
+		next := src.Get()
+		var changed []K
+		for k, v := range next {
+			if old, ok := prev[k]; !ok || !equal(old, v) {
+				changed = append(changed, k)
+			}
+		}
+		for k := range prev {
+			if _, ok := next[k]; !ok {
+				changed = append(changed, k)
+			}
+		}
+
+		// As in SetDiff, keep a private copy of the previous map.
+		prev = make(map[K]V, len(next))
+		for k, v := range next {
+			prev[k] = v
+		}
+
+		if out == nil {
+			out = New(s, changed)
+			return
+		}
+		out.Set(changed)
+	})
+	return out
+}
@@ -0,0 +1,96 @@
+package signals
+
+import (
+	"slices"
+	"testing"
+)
+
+func stringSet(keys ...string) map[string]struct{} {
+	m := make(map[string]struct{}, len(keys))
+	for _, k := range keys {
+		m[k] = struct{}{}
+	}
+	return m
+}
+
+func sortedStrings(keys []string) []string {
+	out := slices.Clone(keys)
+	slices.Sort(out)
+	return out
+}
+
+func TestSetDiff_InitialValueAddsEverything(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := New(s, stringSet("a", "b"))
+	diff := SetDiff(s, src)
+
+	change := diff.Get()
+	if got := sortedStrings(change.Added); !slices.Equal(got, []string{"a", "b"}) {
+		t.Errorf("Expected initial Added to be [a b], got %v", got)
+	}
+	if len(change.Removed) != 0 {
+		t.Errorf("Expected initial Removed to be empty, got %v", change.Removed)
+	}
+}
+
+func TestSetDiff_TracksAddedAndRemoved(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := New(s, stringSet("a", "b"))
+	diff := SetDiff(s, src)
+
+	tests := []struct {
+		next    map[string]struct{}
+		added   []string
+		removed []string
+	}{
+		{stringSet("a", "b", "c"), []string{"c"}, nil},
+		{stringSet("b", "c"), nil, []string{"a"}},
+		{stringSet("d"), []string{"d"}, []string{"b", "c"}},
+		{stringSet("d"), nil, nil},
+		{stringSet(), nil, []string{"d"}},
+	}
+
+	for i, tt := range tests {
+		src.Set(tt.next)
+		change := diff.Get()
+		if got := sortedStrings(change.Added); !slices.Equal(got, tt.added) {
+			t.Errorf("step %d: expected Added %v, got %v", i, tt.added, got)
+		}
+		if got := sortedStrings(change.Removed); !slices.Equal(got, tt.removed) {
+			t.Errorf("step %d: expected Removed %v, got %v", i, tt.removed, got)
+		}
+	}
+}
+
+func TestChangedKeys_ReportsAddedRemovedAndChanged(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := New(s, map[string]int{"a": 1, "b": 2})
+	changed := ChangedKeys(s, src, func(a, b int) bool { return a == b })
+	if got := sortedStrings(changed.Get()); !slices.Equal(got, []string{"a", "b"}) {
+		t.Errorf("Expected every key initially, got %v", got)
+	}
+
+	src.Set(map[string]int{"a": 1, "b": 3})
+	if got := sortedStrings(changed.Get()); !slices.Equal(got, []string{"b"}) {
+		t.Errorf("Expected the value-only change [b], got %v", got)
+	}
+
+	src.Set(map[string]int{"b": 3, "c": 4})
+	if got := sortedStrings(changed.Get()); !slices.Equal(got, []string{"a", "c"}) {
+		t.Errorf("Expected the removal and addition [a c], got %v", got)
+	}
+
+	src.Set(map[string]int{"b": 3, "c": 4})
+	if got := changed.Get(); len(got) != 0 {
+		t.Errorf("Expected no changed keys for an equal map, got %v", got)
+	}
+}
@@ -0,0 +1,69 @@
+package signals
+
+var _ Readonly[int] = (*StableMemo[int])(nil)
+
+// StableMemo is a memo that stops tracking its sources once its value has
+// settled. Create one with MemoUntilStable. It implements Readonly[T].
+type StableMemo[T comparable] struct {
+	m *memo[T]
+	k int
+
+	stableRuns int  // guarded by m.mu
+	settled    bool // guarded by m.mu
+}
+
+// MemoUntilStable is like Memo, but after k consecutive recomputations that
+// each produce a value equal to the one before, the memo detaches from its
+// sources and keeps its value, saving propagation for computations that
+// converge. It stays static, ignoring its sources, until Reset. k below 1
+// is treated as 1. While it tracks its sources, a recomputation that yields
+// the same value doesn't notify subscribers.
+func MemoUntilStable[T comparable](s *Scope, fn func() T, k int) *StableMemo[T] {
+	sm := &StableMemo[T]{m: newMemo(s, fn), k: max(k, 1)}
+	sm.m.afterRun = func(prev, next T, hadPrev bool) bool {
+		if hadPrev && prev == next {
+			sm.stableRuns++
+		} else {
+			sm.stableRuns = 0
+		}
+		sm.settled = sm.stableRuns >= sm.k
+		return sm.settled
+	}
+	return sm
+}
+
+// Get returns the memo's value, subscribing the active computation.
+func (sm *StableMemo[T]) Get() T {
+	return sm.m.Get()
+}
+
+// Peek returns the memo's value like Get, without subscribing the active
+// computation.
+func (sm *StableMemo[T]) Peek() T {
+	return sm.m.Peek()
+}
+
+// Settled reports whether the memo has detached from its sources.
+func (sm *StableMemo[T]) Settled() bool {
+	sm.m.mu.RLock()
+	defer sm.m.mu.RUnlock()
+	return sm.settled
+}
+
+// Reset makes a settled memo track its sources again: it recomputes on its
+// next read and its subscribers are notified. The count of stable
+// recomputations starts over.
+func (sm *StableMemo[T]) Reset() {
+	sm.m.mu.Lock()
+	sm.stableRuns = 0
+	wasSettled := sm.settled
+	sm.settled = false
+	sm.m.mu.Unlock()
+	if wasSettled {
+		sm.m.notify()
+	}
+}
+
+func (sm *StableMemo[T]) subscriberSnapshot() []computation {
+	return sm.m.subscriberSnapshot()
+}
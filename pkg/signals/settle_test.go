@@ -0,0 +1,57 @@
+package signals
+
+import "testing"
+
+func TestMemoUntilStable_DetachesAfterStableRuns(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	input := New(s, 10)
+	runs := 0
+	clamped := MemoUntilStable(s, func() int {
+		runs++
+		return min(input.Get(), 5)
+	}, 2)
+	Effect(s, func() { _ = clamped.Get() })
+
+	input.Set(7) // 5 again: one stable run
+	if clamped.Settled() {
+		t.Fatal("Expected the memo not to settle after one stable run")
+	}
+	input.Set(8) // 5 again: two stable runs
+	if !clamped.Settled() {
+		t.Fatal("Expected the memo to settle after two stable runs")
+	}
+
+	input.Set(1)
+	if runs != 3 || clamped.Get() != 5 {
+		t.Errorf("Expected the settled memo to ignore its sources, ran %d times with value %d", runs, clamped.Get())
+	}
+
+	clamped.Reset()
+	if got := clamped.Get(); got != 1 {
+		t.Errorf("Expected Reset to recompute to 1, got %d", got)
+	}
+	input.Set(2)
+	if got := clamped.Get(); got != 2 {
+		t.Errorf("Expected the reset memo to track its sources again, got %d", got)
+	}
+}
+
+func TestMemoUntilStable_ChangeResetsStableCount(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	input := New(s, 1)
+	m := MemoUntilStable(s, func() int { return input.Get() }, 2)
+	Effect(s, func() { _ = m.Get() })
+
+	input.Set(1) // No-op write, still recomputes: stable run 1.
+	input.Set(2) // Changed: count starts over.
+	input.Set(2) // Stable run 1.
+	if m.Settled() {
+		t.Error("Expected a changed value to restart the stable count")
+	}
+}
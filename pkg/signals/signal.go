@@ -1,16 +1,38 @@
 package signals
 
-import "sync"
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
 
 // Interfaces
 type Readonly[T any] interface {
 	Get() T
+	// Peek returns the current value like Get, but never subscribes the
+	// running computation to it.
+	Peek() T
 }
 
 type Signal[T any] interface {
 	Readonly[T] // Embeds Get()
 	Set(T)
+	// Update applies fn to the value in place and notifies subscribers
+	// like Set does, but without passing through write middleware.
 	Update(func(*T))
+	// Snapshot captures the current value and returns a function that
+	// sets the signal back to it, inside a batch. The value is captured
+	// shallowly. Unlike Engine.Checkpoint, which only records that
+	// signals changed, it restores the value itself. Snapshot was added to
+	// Signal after its first release, so implementations outside this
+	// package must add it too.
+	Snapshot() (restore func())
+	// UpdateBatch is like Update, but fn runs inside a batch that also
+	// holds the signal's own notification, so every write fn makes to
+	// other signals and the update itself reach subscribers in a single
+	// flush. Inside an open batch it joins that batch instead, and nothing
+	// is notified until it ends. fn must not read the signal itself.
+	UpdateBatch(fn func(*T))
 }
 
 // A subscribable is a source that a computable can subscribe to
@@ -23,6 +45,19 @@ type signal[T any] struct {
 	value       T
 	subscribers map[computation]struct{}
 	mu          sync.RWMutex
+
+	// normalize, if set, is applied to every written value before it is stored.
+	normalize func(T) T
+	// equals, if set, lets Set skip notification when the value is unchanged.
+	equals func(a, b T) bool
+
+	// name is set for signals registered with the engine via NewNamed.
+	name string
+	// subscribed records whether the signal has ever had a subscriber. It is
+	// only maintained when the engine audits reads.
+	subscribed atomic.Bool
+	// version counts writes, so checkpoints can tell whether it changed.
+	version atomic.Uint64
 }
 
 func (s *signal[T]) unsubscribe(c computation) {
@@ -32,45 +67,178 @@ func (s *signal[T]) unsubscribe(c computation) {
 }
 
 func (s *signal[T]) Get() T {
+	s.track()
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.value
+}
+
+// Peek returns the current value without subscribing the active listener.
+func (s *signal[T]) Peek() T {
+	s.scope.engine.checkRead()
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.value
+}
+
+// track subscribes the active listener, if any, to s.
+func (s *signal[T]) track() {
+	s.scope.engine.checkRead()
+
 	// If listener, add to our subscribers
-	if listener := s.scope.engine.listener; listener != nil {
+	if listener := s.scope.engine.currentListener(); listener != nil {
 		s.mu.Lock()
 		if s.subscribers == nil {
 			s.subscribers = make(map[computation]struct{})
 		}
 		s.subscribers[listener] = struct{}{}
+		n := len(s.subscribers)
 		s.mu.Unlock()
+		s.scope.engine.checkSubscribers(n, s.name)
+		if s.scope.engine.auditReads {
+			s.subscribed.Store(true)
+		}
 
 		// And tell the listener that it is now subscribed to us.
 		listener.addSource(s)
 	}
-
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-	return s.value
 }
 
 func (s *signal[T]) Set(value T) {
+	if len(s.scope.engine.writeMiddleware) > 0 {
+		throughMiddleware(s.scope.engine, s.name, value, s.set)
+		return
+	}
+	s.set(value)
+}
+
+// throughMiddleware passes v, written to the signal called name, through
+// e's write middleware and calls apply with whatever value reaches the end
+// of the chain, if any.
+func throughMiddleware[T any](e *Engine, name string, v T, apply func(T)) {
+	mws := e.writeMiddleware
+	next := func(_ string, v any) {
+		// A nil interface value has no dynamic type to assert; it stands for
+		// T's zero value, which is nil when T is an interface.
+		if v == nil {
+			var zero T
+			apply(zero)
+			return
+		}
+		apply(v.(T))
+	}
+	for i := len(mws) - 1; i >= 0; i-- {
+		next = mws[i](next)
+	}
+	next(name, v)
+}
+
+func (s *signal[T]) set(value T) {
+	defer s.scope.engine.beginWrite()()
+
+	if s.normalize != nil {
+		value = s.normalize(value)
+	}
+
 	s.mu.Lock()
+	if s.equals != nil && s.equals(s.value, value) {
+		s.mu.Unlock()
+		return
+	}
 	s.value = value
+	s.version.Add(1)
+	subs := s.snapshotSubscribers()
 	s.mu.Unlock()
 
-	s.scope.engine.batchQueueMu.Lock()
-	defer s.scope.engine.batchQueueMu.Unlock()
-	if s.scope.engine.isBatching.Load() {
-		for sub := range s.subscribers {
-			s.scope.engine.batchQueue[sub] = struct{}{}
-		}
-	} else {
-		// Notify subscribers
-		for sub := range s.subscribers {
-			sub.notify()
-		}
+	s.scope.engine.notifyAll(subs)
+}
+
+// hasSubscribers reports whether any computation is subscribed to s.
+func (s *signal[T]) hasSubscribers() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.subscribers) > 0
+}
+
+// snapshotSubscribers copies the current subscribers so they can be notified
+// without holding the lock. Callers must hold s.mu.
+func (s *signal[T]) snapshotSubscribers() []computation {
+	subs := make([]computation, 0, len(s.subscribers))
+	for sub := range s.subscribers {
+		subs = append(subs, sub)
+	}
+	return subs
+}
+
+func (s *signal[T]) Snapshot() (restore func()) {
+	s.mu.RLock()
+	saved := s.value
+	s.mu.RUnlock()
+	return func() {
+		s.scope.Batch(func() {
+			s.Set(saved)
+		})
 	}
 }
 
 func (s *signal[T]) Update(fn func(*T)) {
+	defer s.scope.engine.beginWrite()()
+
+	subs := s.mutate(fn)
+	s.scope.engine.notifyAll(subs)
+}
+
+// mutate applies fn to the value under the lock and returns the subscribers
+// to notify, or nil if the value is unchanged. The lock is released even if
+// fn panics, and before anyone is notified.
+func (s *signal[T]) mutate(fn func(*T)) []computation {
 	s.mu.Lock()
+	defer s.mu.Unlock()
+	old := s.value
 	fn(&s.value)
-	s.mu.Unlock()
+	if s.normalize != nil {
+		s.value = s.normalize(s.value)
+	}
+	if s.equals != nil && s.equals(old, s.value) {
+		return nil
+	}
+	s.version.Add(1)
+	return s.snapshotSubscribers()
+}
+
+func (s *signal[T]) UpdateBatch(fn func(*T)) {
+	s.scope.Batch(func() {
+		s.Update(fn)
+	})
+}
+
+// derived returns a signal kept equal to fn by an effect. Unlike a memo it
+// is eager, but when T is comparable a recomputation that yields the current
+// value does not notify subscribers.
+func derived[T any](s *Scope, fn func() T) Readonly[T] {
+	var zero T
+	out := New(s, zero)
+	Effect(s, func() {
+		out.Set(fn())
+	})
+	return out
+}
+
+// comparableEquals returns an equality func using == if T is comparable, or
+// nil if it isn't. Values that only turn out not to be comparable at run
+// time, such as interfaces holding slices, compare unequal.
+func comparableEquals[T any]() func(a, b T) bool {
+	if !reflect.TypeFor[T]().Comparable() {
+		return nil
+	}
+	return func(a, b T) (equal bool) {
+		defer func() {
+			if recover() != nil {
+				equal = false
+			}
+		}()
+		return any(a) == any(b)
+	}
 }
@@ -1,6 +1,14 @@
 package signals
 
-import "testing"
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
 
 func TestSignal_GetReturnsInitialValue(t *testing.T) {
 	eng := Start()
@@ -41,3 +49,347 @@ func TestSignal_UpdateMutatesValue(t *testing.T) {
 		t.Errorf("Expected updated value to be 30, got %v", val)
 	}
 }
+
+func TestSignal_NewNormalizedClampsWrites(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	clamp := func(v int) int {
+		return max(0, min(v, 100))
+	}
+	percent := NewNormalized(s, 150, clamp)
+
+	if val := percent.Get(); val != 100 {
+		t.Errorf("Expected initial value to be clamped to 100, got %v", val)
+	}
+
+	percent.Set(-20)
+	if val := percent.Get(); val != 0 {
+		t.Errorf("Expected Set to clamp to 0, got %v", val)
+	}
+
+	percent.Update(func(v *int) {
+		*v += 500
+	})
+	if val := percent.Get(); val != 100 {
+		t.Errorf("Expected Update to clamp to 100, got %v", val)
+	}
+}
+
+func TestSignal_NewNormalizedSkipsEqualWrites(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	name := NewNormalized(s, "alice", strings.TrimSpace)
+	runCount := 0
+	Effect(s, func() {
+		_ = name.Get()
+		runCount++
+	})
+
+	name.Set("  alice  ")
+	if runCount != 1 {
+		t.Errorf("Expected effect not to re-run for a value that normalizes to the current one, ran %d times", runCount)
+	}
+
+	name.Set(" bob")
+	if runCount != 2 {
+		t.Errorf("Expected effect to re-run on a real change, ran %d times", runCount)
+	}
+	if val := name.Get(); val != "bob" {
+		t.Errorf("Expected stored value to be normalized to %q, got %q", "bob", val)
+	}
+}
+
+// resubscribingComputation re-reads its source from inside notify, mutating
+// the source's subscriber set while the source is still notifying.
+type resubscribingComputation struct {
+	recordingComputation
+	eng  *Engine
+	read func()
+}
+
+func (r *resubscribingComputation) notify() {
+	r.notified++
+	r.eng.withListener(r, r.read)
+}
+
+func TestSignal_SubscriberReadingDuringNotifyIsSafe(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 0)
+	subs := make([]*resubscribingComputation, 3)
+	for i := range subs {
+		subs[i] = &resubscribingComputation{eng: eng}
+		subs[i].read = func() { _ = count.Get() }
+		eng.withListener(subs[i], subs[i].read)
+	}
+
+	for v := 1; v <= 5; v++ {
+		count.Set(v)
+	}
+	for i, sub := range subs {
+		if sub.notified != 5 {
+			t.Errorf("subscriber %d: expected 5 notifications, got %d", i, sub.notified)
+		}
+	}
+}
+
+func TestSignal_ConcurrentSetsWithResubscribingEffects(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 0)
+	doubled := Memo(s, func() int { return count.Get() * 2 })
+	var runs atomic.Int64
+	for range 3 {
+		Effect(s, func() {
+			_ = count.Get()
+			_ = doubled.Get()
+			runs.Add(1)
+		})
+	}
+
+	var wg sync.WaitGroup
+	for g := range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 100 {
+				count.Set(g*1000 + i + 1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if runs.Load() <= 3 {
+		t.Errorf("Expected effects to re-run, ran %d times", runs.Load())
+	}
+}
+
+func TestSignal_NewNormalizedNilFunctionPanicsWithClearError(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	if got := capturePanic(func() { NewNormalized[int](s, 0, nil) }); got != ErrNilNormalizeFunc {
+		t.Errorf("Expected NewNormalized to panic with ErrNilNormalizeFunc, got %v", got)
+	}
+}
+
+func TestSignal_WriteMiddlewareTransformsAndVetoes(t *testing.T) {
+	var log []string
+	eng := Start(
+		WithWriteMiddleware(func(next func(string, any)) func(string, any) {
+			return func(name string, v any) {
+				log = append(log, fmt.Sprintf("%s=%v", name, v))
+				next(name, v)
+			}
+		}),
+		WithWriteMiddleware(func(next func(string, any)) func(string, any) {
+			return func(name string, v any) {
+				if n, ok := v.(int); ok {
+					if n < 0 {
+						return
+					}
+					v = n * 2
+				}
+				next(name, v)
+			}
+		}),
+	)
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := NewNamed(s, "count", 1)
+	runs := 0
+	Effect(s, func() {
+		_ = count.Get()
+		runs++
+	})
+
+	count.Set(5)
+	if got := count.Get(); got != 10 {
+		t.Errorf("Expected the doubled value 10, got %d", got)
+	}
+	count.Set(-1)
+	if got := count.Get(); got != 10 {
+		t.Errorf("Expected the vetoed write to be dropped, got %d", got)
+	}
+	if runs != 2 {
+		t.Errorf("Expected only the applied write to notify, ran %d times", runs)
+	}
+	if want := []string{"count=5", "count=-1"}; !slices.Equal(log, want) {
+		t.Errorf("Expected the outer middleware to see %v, got %v", want, log)
+	}
+}
+
+func TestSignal_WriteMiddlewarePassesNilInterfaceValues(t *testing.T) {
+	eng := Start(WithWriteMiddleware(func(next func(string, any)) func(string, any) {
+		return next
+	}))
+	defer eng.Close()
+	s := eng.Scope()
+
+	err := New[error](s, errors.New("boom"))
+	if got := capturePanic(func() { err.Set(nil) }); got != nil {
+		t.Fatalf("Expected writing nil through middleware not to panic, got %v", got)
+	}
+	if got := err.Get(); got != nil {
+		t.Errorf("Expected the signal to hold nil, got %v", got)
+	}
+}
+
+func TestSignal_UpdateBatchNotifiesOnce(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	type form struct {
+		Name, Email string
+	}
+	profile := New(s, form{})
+	dirty := New(s, false)
+	runs := 0
+	Effect(s, func() {
+		_ = profile.Get()
+		_ = dirty.Get()
+		runs++
+	})
+
+	profile.UpdateBatch(func(f *form) {
+		f.Name = "Ada"
+		f.Email = "ada@example.com"
+		dirty.Set(true)
+	})
+
+	if runs != 2 {
+		t.Errorf("Expected a single re-run for the whole update, ran %d times", runs)
+	}
+	if got := profile.Get(); got.Name != "Ada" || got.Email != "ada@example.com" {
+		t.Errorf("Expected both fields updated, got %+v", got)
+	}
+}
+
+func TestSignal_SetSkipsEqualValues(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 1)
+	runs := 0
+	Effect(s, func() {
+		_ = count.Get()
+		runs++
+	})
+
+	count.Set(count.Get())
+	count.Update(func(v *int) { *v += 0 })
+	if runs != 1 {
+		t.Errorf("Expected equal writes not to re-run the effect, ran %d times", runs)
+	}
+	count.Set(2)
+	if runs != 2 {
+		t.Errorf("Expected a new value to re-run the effect, ran %d times", runs)
+	}
+}
+
+func TestSignal_WithEquals(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	items := New(s, []int{1, 2}, WithEquals(slices.Equal[[]int]))
+	always := New(s, 1, WithEquals(func(a, b int) bool { return false }))
+	itemRuns, alwaysRuns := 0, 0
+	Effect(s, func() {
+		_ = items.Get()
+		itemRuns++
+	})
+	Effect(s, func() {
+		_ = always.Get()
+		alwaysRuns++
+	})
+
+	items.Set([]int{1, 2})
+	if itemRuns != 1 {
+		t.Errorf("Expected an equal slice not to re-run the effect, ran %d times", itemRuns)
+	}
+	items.Set([]int{1, 2, 3})
+	if itemRuns != 2 {
+		t.Errorf("Expected a different slice to re-run the effect, ran %d times", itemRuns)
+	}
+	always.Set(1)
+	if alwaysRuns != 2 {
+		t.Errorf("Expected a custom equals reporting inequality to re-run the effect, ran %d times", alwaysRuns)
+	}
+}
+
+func TestSignal_InterfaceHoldingUncomparableValue(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	v := New[any](s, []int{1})
+	runs := 0
+	Effect(s, func() {
+		_ = v.Get()
+		runs++
+	})
+	v.Set([]int{1})
+	if runs != 2 {
+		t.Errorf("Expected an uncomparable dynamic value to count as a change, ran %d times", runs)
+	}
+}
+
+func TestSignal_PeekDoesNotSubscribe(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 1)
+	flag := New(s, false)
+	runs := 0
+	var seen bool
+	Effect(s, func() {
+		_ = count.Get()
+		seen = flag.Peek()
+		runs++
+	})
+
+	flag.Set(true)
+	if runs != 1 {
+		t.Errorf("Expected a peeked signal not to re-run the effect, ran %d times", runs)
+	}
+	count.Set(2)
+	if runs != 2 || !seen {
+		t.Errorf("Expected the next run to peek the current value, ran %d times and saw %v", runs, seen)
+	}
+}
+
+func TestSignal_SnapshotRestoresValue(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	name := New(s, "draft")
+	count := NewAtomicInt64(s, 1)
+	restoreName := name.Snapshot()
+	restoreCount := count.Snapshot()
+	name.Set("edited")
+	count.Set(5)
+
+	restoreName()
+	restoreCount()
+	if got := name.Get(); got != "draft" {
+		t.Errorf("Expected the name to be restored to %q, got %q", "draft", got)
+	}
+	if got := count.Get(); got != 1 {
+		t.Errorf("Expected the count to be restored to 1, got %d", got)
+	}
+}
@@ -0,0 +1,114 @@
+package signalstest
+
+import (
+	"sync"
+	"time"
+
+	"github.com/edgarvarela24/signals-go/pkg/signals"
+)
+
+// FakeClock is a signals.Clock whose time only moves when Advance is called. Timers
+// fire synchronously on the goroutine calling Advance, in deadline order,
+// which makes time-based features fully deterministic in tests.
+type FakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []*fakeTimer
+	seq    uint64
+}
+
+type fakeTimer struct {
+	clock *FakeClock
+	when  time.Time
+	seq   uint64
+	fn    func()
+}
+
+// NewFakeClock creates a FakeClock set to start.
+func NewFakeClock(start time.Time) *FakeClock {
+	return &FakeClock{now: start}
+}
+
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	ch := make(chan time.Time, 1)
+	c.AfterFunc(d, func() {
+		ch <- c.Now()
+	})
+	return ch
+}
+
+func (c *FakeClock) AfterFunc(d time.Duration, f func()) signals.Timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.seq++
+	t := &fakeTimer{clock: c, when: c.now.Add(d), seq: c.seq, fn: f}
+	c.timers = append(c.timers, t)
+	return t
+}
+
+// Advance moves the clock forward by d, firing every timer whose deadline is
+// reached along the way. Timers scheduled by fired callbacks also fire if
+// they fall within the window.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	target := c.now.Add(d)
+	for {
+		next := c.nextDue(target)
+		if next == nil {
+			break
+		}
+		c.now = next.when
+		c.mu.Unlock()
+		next.fn()
+		c.mu.Lock()
+	}
+	c.now = target
+	c.mu.Unlock()
+}
+
+// Pending returns the number of timers that have not fired or been stopped.
+func (c *FakeClock) Pending() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.timers)
+}
+
+// nextDue removes and returns the earliest timer due at or before target.
+// Callers must hold c.mu.
+func (c *FakeClock) nextDue(target time.Time) *fakeTimer {
+	idx := -1
+	for i, t := range c.timers {
+		if t.when.After(target) {
+			continue
+		}
+		if idx == -1 || t.when.Before(c.timers[idx].when) ||
+			(t.when.Equal(c.timers[idx].when) && t.seq < c.timers[idx].seq) {
+			idx = i
+		}
+	}
+	if idx == -1 {
+		return nil
+	}
+	t := c.timers[idx]
+	c.timers = append(c.timers[:idx], c.timers[idx+1:]...)
+	return t
+}
+
+func (t *fakeTimer) Stop() bool {
+	c := t.clock
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, other := range c.timers {
+		if other == t {
+			c.timers = append(c.timers[:i], c.timers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
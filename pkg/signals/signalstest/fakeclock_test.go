@@ -0,0 +1,65 @@
+package signalstest
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFakeClock_AdvanceFiresTimersInOrder(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+
+	var fired []string
+	clock.AfterFunc(20*time.Millisecond, func() { fired = append(fired, "b") })
+	clock.AfterFunc(10*time.Millisecond, func() { fired = append(fired, "a") })
+	clock.AfterFunc(30*time.Millisecond, func() { fired = append(fired, "c") })
+
+	clock.Advance(25 * time.Millisecond)
+	if len(fired) != 2 || fired[0] != "a" || fired[1] != "b" {
+		t.Fatalf("Expected timers a and b to fire in order, got %v", fired)
+	}
+	if now := clock.Now(); !now.Equal(start.Add(25 * time.Millisecond)) {
+		t.Errorf("Expected clock to read start+25ms, got %v", now)
+	}
+
+	clock.Advance(5 * time.Millisecond)
+	if len(fired) != 3 || fired[2] != "c" {
+		t.Errorf("Expected timer c to fire, got %v", fired)
+	}
+}
+
+func TestFakeClock_StopPreventsFiring(t *testing.T) {
+	clock := NewFakeClock(time.Time{})
+
+	fired := false
+	timer := clock.AfterFunc(time.Second, func() { fired = true })
+	if !timer.Stop() {
+		t.Error("Expected Stop to report the timer as pending")
+	}
+
+	clock.Advance(2 * time.Second)
+	if fired {
+		t.Error("Expected stopped timer not to fire")
+	}
+	if timer.Stop() {
+		t.Error("Expected second Stop to report the timer as no longer pending")
+	}
+}
+
+func TestFakeClock_After(t *testing.T) {
+	clock := NewFakeClock(time.Time{})
+	ch := clock.After(time.Minute)
+
+	select {
+	case <-ch:
+		t.Fatal("Expected After not to fire before the clock advances")
+	default:
+	}
+
+	clock.Advance(time.Minute)
+	select {
+	case <-ch:
+	default:
+		t.Error("Expected After to fire once the clock advanced")
+	}
+}
@@ -0,0 +1,41 @@
+package signalstest
+
+import "sync"
+
+// SteppableScheduler is a signals.Scheduler that only queues effect runs,
+// leaving the test to execute them one at a time with Step, so it can
+// observe the state between runs. It is safe for concurrent use.
+type SteppableScheduler struct {
+	mu    sync.Mutex
+	queue []func()
+}
+
+// Schedule queues fn.
+func (s *SteppableScheduler) Schedule(fn func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.queue = append(s.queue, fn)
+}
+
+// Step runs the oldest queued run, reporting whether there was one to run.
+// Runs it queues are left for later steps.
+func (s *SteppableScheduler) Step() bool {
+	s.mu.Lock()
+	if len(s.queue) == 0 {
+		s.mu.Unlock()
+		return false
+	}
+	fn := s.queue[0]
+	s.queue = s.queue[1:]
+	s.mu.Unlock()
+
+	fn()
+	return true
+}
+
+// Pending returns the number of queued runs.
+func (s *SteppableScheduler) Pending() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.queue)
+}
@@ -0,0 +1,48 @@
+package signalstest
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/edgarvarela24/signals-go/pkg/signals"
+)
+
+func TestSteppableScheduler_RunsOneEffectPerStep(t *testing.T) {
+	sched := &SteppableScheduler{}
+	eng := signals.Start(signals.WithScheduler(sched))
+	defer eng.Close()
+	s := eng.Scope()
+
+	a := signals.New(s, 0)
+	b := signals.New(s, 0)
+	var ran []string
+	signals.Effect(s, func() {
+		b.Set(a.Get() * 10)
+		ran = append(ran, "copy")
+	})
+	signals.Effect(s, func() {
+		_ = b.Get()
+		ran = append(ran, "read")
+	})
+	ran = nil
+
+	a.Set(1)
+	if sched.Pending() != 1 || len(ran) != 0 {
+		t.Fatalf("Expected one queued run and nothing executed, got %d queued and %v", sched.Pending(), ran)
+	}
+
+	if !sched.Step() || !slices.Equal(ran, []string{"copy"}) {
+		t.Fatalf("Expected the first step to run only the copy, ran %v", ran)
+	}
+	if b.Get() != 10 || sched.Pending() != 1 {
+		t.Errorf("Expected b written and the reader queued, got b=%d with %d queued", b.Get(), sched.Pending())
+	}
+
+	if !sched.Step() || !slices.Equal(ran, []string{"copy", "read"}) {
+		t.Errorf("Expected the second step to run the reader, ran %v", ran)
+	}
+	if sched.Step() {
+		t.Error("Expected no work left")
+	}
+	AssertQuiescent(t, eng)
+}
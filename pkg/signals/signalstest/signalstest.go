@@ -0,0 +1,32 @@
+// Package signalstest provides helpers for testing code built on signals.
+package signalstest
+
+import (
+	"testing"
+
+	"github.com/edgarvarela24/signals-go/pkg/signals"
+)
+
+// AssertQuiescent fails t if eng has pending work: an open batch, queued
+// notifications that were never flushed, or effect runs still waiting on a
+// dispatcher. Call it at the end of a test to catch leaving the engine dirty.
+func AssertQuiescent(t testing.TB, eng *signals.Engine) {
+	t.Helper()
+	if p := eng.Pending(); !p.Idle() {
+		t.Errorf("signals: engine is not quiescent: batching=%v queued=%d scheduled=%d",
+			p.Batching, p.Queued, p.Scheduled)
+	}
+}
+
+// Capture runs fn with a fresh probe as the active listener on eng, so every
+// read inside fn subscribes the probe as it would a running effect or memo,
+// and returns the probe. Writing to what fn read then shows up in
+// Notified, which lets a test check that a custom source records its
+// readers. The probe is stopped when t finishes.
+func Capture(t testing.TB, eng *signals.Engine, fn func()) *signals.Probe {
+	t.Helper()
+	p := signals.NewProbe(eng)
+	t.Cleanup(p.Stop)
+	p.Run(fn)
+	return p
+}
@@ -0,0 +1,115 @@
+package signalstest
+
+import (
+	"testing"
+
+	"github.com/edgarvarela24/signals-go/pkg/signals"
+)
+
+// recordingT captures failures instead of failing the enclosing test.
+type recordingT struct {
+	testing.TB
+	failed bool
+}
+
+func (r *recordingT) Helper() {}
+
+func (r *recordingT) Errorf(format string, args ...any) {
+	r.failed = true
+}
+
+func TestAssertQuiescent_PassesWhenIdle(t *testing.T) {
+	eng := signals.Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := signals.New(s, 0)
+	signals.Effect(s, func() {
+		_ = count.Get()
+	})
+	s.Batch(func() {
+		count.Set(1)
+	})
+
+	AssertQuiescent(t, eng)
+}
+
+func TestAssertQuiescent_FailsWithOpenBatch(t *testing.T) {
+	eng := signals.Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := signals.New(s, 0)
+	signals.Effect(s, func() {
+		_ = count.Get()
+	})
+
+	rec := &recordingT{TB: t}
+	s.Batch(func() {
+		count.Set(1)
+		AssertQuiescent(rec, eng)
+	})
+
+	if !rec.failed {
+		t.Error("Expected AssertQuiescent to fail while a batch is open")
+	}
+}
+
+func TestAssertQuiescent_FailsWithUndispatchedEffects(t *testing.T) {
+	var queue []func()
+	eng := signals.Start(signals.WithDispatchGoroutine(func(fn func()) {
+		queue = append(queue, fn)
+	}))
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := signals.New(s, 0)
+	signals.Effect(s, func() {
+		_ = count.Get()
+	})
+	count.Set(1)
+
+	rec := &recordingT{TB: t}
+	AssertQuiescent(rec, eng)
+	if !rec.failed {
+		t.Error("Expected AssertQuiescent to fail with a scheduled effect")
+	}
+
+	for _, fn := range queue {
+		fn()
+	}
+	AssertQuiescent(t, eng)
+}
+
+// celsius is a custom source built on a signal, as a user might write one.
+type celsius struct {
+	kelvin signals.Signal[float64]
+}
+
+func (c celsius) Get() float64 {
+	return c.kelvin.Get() - 273.15
+}
+
+func TestCapture_RecordsReadsOfCustomSource(t *testing.T) {
+	eng := signals.Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	temp := celsius{kelvin: signals.New(s, 273.15)}
+	other := signals.New(s, 0)
+	p := Capture(t, eng, func() {
+		_ = temp.Get()
+	})
+
+	other.Set(1)
+	if got := p.Notified(); got != 0 {
+		t.Errorf("Expected no notification from a source that wasn't read, got %d", got)
+	}
+	temp.kelvin.Set(300)
+	if got := p.Notified(); got != 1 {
+		t.Errorf("Expected the read inside Capture to subscribe the probe, got %d notifications", got)
+	}
+	if eng.ListenerDepth() != 0 {
+		t.Errorf("Expected the listener to be popped after Capture, got depth %d", eng.ListenerDepth())
+	}
+}
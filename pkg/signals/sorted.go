@@ -0,0 +1,51 @@
+package signals
+
+import "slices"
+
+// SortedOption configures Sorted.
+type SortedOption func(*sortedConfig)
+
+type sortedConfig struct {
+	reuse bool
+}
+
+// ReuseBuffer makes Sorted sort into the slice it returned last time when it
+// is large enough, instead of allocating a new one on every change. A slice
+// obtained from an earlier Get is then overwritten by the next
+// recomputation, so readers must not keep it.
+func ReuseBuffer() SortedOption {
+	return func(c *sortedConfig) {
+		c.reuse = true
+	}
+}
+
+// Sorted returns a computed value holding a copy of src sorted by less. The
+// sort is stable: elements that neither precedes the other keep their order
+// in src. src itself is never modified. Like Memo it is lazy and cold.
+func Sorted[T any](s *Scope, src Readonly[[]T], less func(a, b T) bool, opts ...SortedOption) Readonly[[]T] {
+	var cfg sortedConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	var buf []T
+	return Memo(s, func() []T {
+		in := src.Get()
+		var out []T
+		if cfg.reuse {
+			out = append(buf[:0], in...)
+			buf = out
+		} else {
+			out = slices.Clone(in)
+		}
+		slices.SortStableFunc(out, func(a, b T) int {
+			switch {
+			case less(a, b):
+				return -1
+			case less(b, a):
+				return 1
+			}
+			return 0
+		})
+		return out
+	})
+}
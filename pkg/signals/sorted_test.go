@@ -0,0 +1,51 @@
+package signals
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSorted_FollowsSourceWithoutMutatingIt(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := New(s, []int{3, 1, 2})
+	sorted := Sorted(s, src, func(a, b int) bool { return a < b })
+
+	if got := sorted.Get(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", got)
+	}
+	if got := src.Get(); !slices.Equal(got, []int{3, 1, 2}) {
+		t.Errorf("Expected the source to keep its order, got %v", got)
+	}
+
+	src.Set([]int{5, 4})
+	if got := sorted.Get(); !slices.Equal(got, []int{4, 5}) {
+		t.Errorf("Expected the view to update to [4 5], got %v", got)
+	}
+}
+
+func TestSorted_IsStableAndCanReuseBuffer(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	type item struct {
+		key  int
+		name string
+	}
+	src := New(s, []item{{2, "a"}, {1, "b"}, {2, "c"}, {1, "d"}})
+	sorted := Sorted(s, src, func(a, b item) bool { return a.key < b.key }, ReuseBuffer())
+
+	first := sorted.Get()
+	if want := []item{{1, "b"}, {1, "d"}, {2, "a"}, {2, "c"}}; !slices.Equal(first, want) {
+		t.Errorf("Expected equal keys to keep their order, got %v", first)
+	}
+
+	src.Set([]item{{0, "z"}})
+	second := sorted.Get()
+	if len(second) != 1 || &second[0] != &first[0] {
+		t.Errorf("Expected the previous allocation to be reused, got %v", second)
+	}
+}
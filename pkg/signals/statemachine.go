@@ -0,0 +1,53 @@
+package signals
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrInvalidTransition is returned by StateMachine.Send when the current
+// state has no transition for the event.
+var ErrInvalidTransition = errors.New("signals: invalid transition")
+
+// StateMachine is a finite state machine whose current state is reactive.
+type StateMachine[S comparable, E comparable] struct {
+	scope       *Scope
+	current     Signal[S]
+	transitions map[S]map[E]S
+	mu          sync.Mutex // serializes transitions
+}
+
+// NewStateMachine creates a state machine starting in initial. transitions
+// maps each state to the events it accepts and the state each leads to.
+func NewStateMachine[S comparable, E comparable](s *Scope, initial S, transitions map[S]map[E]S) *StateMachine[S, E] {
+	return &StateMachine[S, E]{
+		scope:       s,
+		current:     New(s, initial),
+		transitions: transitions,
+	}
+}
+
+// Current returns the current state as a reactive value.
+func (m *StateMachine[S, E]) Current() Readonly[S] {
+	return m.current
+}
+
+// Send applies event to the current state. If the current state has no
+// transition for event, the state is left unchanged and an error wrapping
+// ErrInvalidTransition is returned.
+func (m *StateMachine[S, E]) Send(event E) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var from S
+	Untrack(m.scope, func() {
+		from = m.current.Get()
+	})
+	to, ok := m.transitions[from][event]
+	if !ok {
+		return fmt.Errorf("%w: no transition from %v on %v", ErrInvalidTransition, from, event)
+	}
+	m.current.Set(to)
+	return nil
+}
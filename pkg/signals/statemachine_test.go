@@ -0,0 +1,67 @@
+package signals
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+type doorState string
+type doorEvent string
+
+func newDoor(s *Scope) *StateMachine[doorState, doorEvent] {
+	return NewStateMachine(s, "closed", map[doorState]map[doorEvent]doorState{
+		"closed": {"open": "opened", "lock": "locked"},
+		"opened": {"close": "closed"},
+		"locked": {"unlock": "closed"},
+	})
+}
+
+func TestStateMachine_ValidTransitionsUpdateState(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	door := newDoor(s)
+	var seen []doorState
+	Effect(s, func() {
+		seen = append(seen, door.Current().Get())
+	})
+
+	for _, ev := range []doorEvent{"open", "close", "lock", "unlock"} {
+		if err := door.Send(ev); err != nil {
+			t.Fatalf("Send(%q) returned an error: %v", ev, err)
+		}
+	}
+
+	want := []doorState{"closed", "opened", "closed", "locked", "closed"}
+	if !slices.Equal(seen, want) {
+		t.Errorf("Expected effect to observe %v, got %v", want, seen)
+	}
+}
+
+func TestStateMachine_InvalidEventsAreRejected(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	door := newDoor(s)
+	_ = door.Send("lock")
+
+	runCount := 0
+	Effect(s, func() {
+		_ = door.Current().Get()
+		runCount++
+	})
+
+	err := door.Send("open")
+	if !errors.Is(err, ErrInvalidTransition) {
+		t.Errorf("Expected ErrInvalidTransition, got %v", err)
+	}
+	if state := door.Current().Get(); state != "locked" {
+		t.Errorf("Expected state to stay locked, got %q", state)
+	}
+	if runCount != 1 {
+		t.Errorf("Expected no notification for a rejected event, ran %d times", runCount)
+	}
+}
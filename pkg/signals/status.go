@@ -0,0 +1,52 @@
+package signals
+
+// statusSource is implemented by sources whose value can be out of date.
+// peekStatus reports the current value and whether it is stale without
+// bringing it up to date, and subscribes the active listener to both.
+type statusSource[T any] interface {
+	peekStatus() (T, bool)
+}
+
+// WithStatus wraps r so a single reactive read yields its value together
+// with whether that value is stale. A memo is stale while a dependency has
+// changed and it hasn't been recomputed; reading the status doesn't
+// recompute it, and readers are notified once something else does. A
+// MapAsync result is stale while the call for its latest input is in
+// flight. For plain signals Stale is always false.
+func WithStatus[T any](s *Scope, r Readonly[T]) Readonly[struct {
+	Value T
+	Stale bool
+}] {
+	return statusReader[T]{scope: s, src: r}
+}
+
+type statusReader[T any] struct {
+	scope *Scope
+	src   Readonly[T]
+}
+
+func (r statusReader[T]) Peek() (out struct {
+	Value T
+	Stale bool
+}) {
+	Untrack(r.scope, func() {
+		out = r.Get()
+	})
+	return out
+}
+
+func (r statusReader[T]) Get() struct {
+	Value T
+	Stale bool
+} {
+	out := struct {
+		Value T
+		Stale bool
+	}{}
+	if src, ok := r.src.(statusSource[T]); ok {
+		out.Value, out.Stale = src.peekStatus()
+	} else {
+		out.Value = r.src.Get()
+	}
+	return out
+}
@@ -0,0 +1,84 @@
+package signals
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestWithStatus_MemoIsStaleUntilRecomputed(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 1)
+	doubled := Memo(s, func() int { return count.Get() * 2 })
+	status := WithStatus(s, doubled)
+
+	var stale []bool
+	Effect(s, func() {
+		stale = append(stale, status.Get().Stale)
+	})
+	_ = doubled.Get()
+
+	count.Set(2)
+	if got := status.Get(); !got.Stale || got.Value != 2 {
+		t.Errorf("Expected the stale cached value 2, got %+v", got)
+	}
+
+	_ = doubled.Get()
+	if got := status.Get(); got.Stale || got.Value != 4 {
+		t.Errorf("Expected the fresh value 4, got %+v", got)
+	}
+	if want := []bool{true, false, true, false}; !slices.Equal(stale, want) {
+		t.Errorf("Expected the effect to observe staleness %v, got %v", want, stale)
+	}
+}
+
+func TestWithStatus_SignalIsNeverStale(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	count := New(s, 1)
+	status := WithStatus(s, count)
+	count.Set(2)
+	if got := status.Get(); got.Stale || got.Value != 2 {
+		t.Errorf("Expected {2 false}, got %+v", got)
+	}
+}
+
+func TestWithStatus_MapAsyncIsStaleWhileInFlight(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := New(s, 1)
+	release := make(chan struct{})
+	out := MapAsync(s, src, func(ctx context.Context, v int) (int, error) {
+		<-release
+		return v * 10, nil
+	})
+	status := WithStatus(s, out)
+
+	if got := status.Get(); !got.Stale {
+		t.Errorf("Expected the result to be stale while the call runs, got %+v", got)
+	}
+
+	settled := make(chan struct{}, 1)
+	Effect(s, func() {
+		if !status.Get().Stale {
+			settled <- struct{}{}
+		}
+	})
+	close(release)
+	select {
+	case <-settled:
+	case <-time.After(time.Second):
+		t.Fatal("Expected the result to stop being stale once the call completed")
+	}
+	if got := status.Get(); got.Value != 10 {
+		t.Errorf("Expected value 10, got %+v", got)
+	}
+}
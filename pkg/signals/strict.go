@@ -0,0 +1,60 @@
+package signals
+
+import "errors"
+
+// ErrReadDuringWrite is the panic value raised in strict mode when a signal
+// is read from outside any computation while a write is still in flight.
+var ErrReadDuringWrite = errors.New("signals: read from outside a computation while a write is in flight")
+
+// WithStrictMode enables development-time checks that panic on misuse.
+//
+// In strict mode, reading a signal or memo while a Set or Update is in
+// flight panics with ErrReadDuringWrite, unless the read comes from a
+// computation (including its Untrack blocks) that is being re-run by that
+// write. Such reads come from normalizers, equality funcs, Update mutators or
+// plain callbacks invoked mid-propagation, and can observe half-applied
+// state. Writes are tracked per goroutine, so a read is only checked against
+// writes in flight on its own goroutine.
+func WithStrictMode() Option {
+	return func(e *Engine) {
+		e.strict = true
+	}
+}
+
+// beginWrite marks a write as in flight and returns a func that ends it.
+func (e *Engine) beginWrite() (end func()) {
+	if !e.strict {
+		return func() {}
+	}
+	id := goroutineID()
+	e.writesMu.Lock()
+	if e.writes == nil {
+		e.writes = make(map[uint64]int)
+	}
+	e.writes[id]++
+	e.writesMu.Unlock()
+	e.writeDepth.Add(1)
+	return func() {
+		e.writeDepth.Add(-1)
+		e.writesMu.Lock()
+		defer e.writesMu.Unlock()
+		if e.writes[id]--; e.writes[id] == 0 {
+			delete(e.writes, id)
+		}
+	}
+}
+
+// checkRead panics in strict mode if a read happens outside any computation
+// while a write is in flight on the same goroutine.
+func (e *Engine) checkRead() {
+	// Finding the goroutine is costly; skip it when no write is in flight.
+	if !e.strict || e.writeDepth.Load() == 0 {
+		return
+	}
+	e.writesMu.Lock()
+	writing := e.writes[goroutineID()] > 0
+	e.writesMu.Unlock()
+	if writing && e.ListenerDepth() == 0 {
+		panic(ErrReadDuringWrite)
+	}
+}
@@ -0,0 +1,131 @@
+package signals
+
+import "testing"
+
+// readingComputation reads a signal from its notify, outside of any
+// tracked computation.
+type readingComputation struct {
+	recordingComputation
+	read func()
+}
+
+func (r *readingComputation) notify() {
+	r.read()
+}
+
+func capturePanic(fn func()) (recovered any) {
+	defer func() {
+		recovered = recover()
+	}()
+	fn()
+	return nil
+}
+
+func TestStrictMode_PanicsOnReadDuringUpdate(t *testing.T) {
+	eng := Start(WithStrictMode())
+	defer eng.Close()
+	s := eng.Scope()
+
+	a := New(s, 1)
+	b := New(s, 2)
+
+	got := capturePanic(func() {
+		a.Update(func(v *int) {
+			*v += b.Get()
+		})
+	})
+	if got != ErrReadDuringWrite {
+		t.Errorf("Expected ErrReadDuringWrite, got %v", got)
+	}
+
+	// The write must have been unwound so normal reads work again.
+	if got := capturePanic(func() { _ = b.Get() }); got != nil {
+		t.Errorf("Expected read after the failed write to succeed, got %v", got)
+	}
+}
+
+func TestStrictMode_PanicsOnUntrackedReadDuringNotify(t *testing.T) {
+	eng := Start(WithStrictMode())
+	defer eng.Close()
+	s := eng.Scope()
+
+	a := New(s, 1)
+	b := New(s, 2)
+	sub := &readingComputation{read: func() { _ = b.Get() }}
+	eng.withListener(sub, func() {
+		_ = a.Get()
+	})
+
+	if got := capturePanic(func() { a.Set(5) }); got != ErrReadDuringWrite {
+		t.Errorf("Expected ErrReadDuringWrite, got %v", got)
+	}
+}
+
+func TestStrictMode_AllowsReadsFromComputations(t *testing.T) {
+	eng := Start(WithStrictMode())
+	defer eng.Close()
+	s := eng.Scope()
+
+	a := New(s, 1)
+	b := New(s, 2)
+	doubled := Memo(s, func() int { return a.Get() * 2 })
+	Effect(s, func() {
+		_ = doubled.Get()
+		Untrack(s, func() {
+			_ = b.Get()
+		})
+	})
+
+	if got := capturePanic(func() { a.Set(5) }); got != nil {
+		t.Errorf("Expected reads from a re-running effect to be allowed, got %v", got)
+	}
+}
+
+func TestStrictMode_DisabledByDefault(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	a := New(s, 1)
+	b := New(s, 2)
+
+	got := capturePanic(func() {
+		a.Update(func(v *int) {
+			*v += b.Get()
+		})
+	})
+	if got != nil {
+		t.Errorf("Expected no panic outside strict mode, got %v", got)
+	}
+	if val := a.Get(); val != 3 {
+		t.Errorf("Expected a to be 3, got %d", val)
+	}
+}
+
+func TestStrictMode_AllowsReadsOnOtherGoroutines(t *testing.T) {
+	eng := Start(WithStrictMode())
+	defer eng.Close()
+	s := eng.Scope()
+
+	a := New(s, 1)
+	b := New(s, 2)
+	inWrite := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		a.Update(func(v *int) {
+			close(inWrite)
+			<-release
+			*v++
+		})
+	}()
+
+	<-inWrite
+	got := capturePanic(func() { _ = b.Get() })
+	close(release)
+	<-done
+	if got != nil {
+		t.Errorf("Expected a read on another goroutine to be allowed, got %v", got)
+	}
+}
@@ -0,0 +1,14 @@
+package signals
+
+// Switch returns a computed value that evaluates only the case matching the
+// current key, or defaultFn if no case matches. Only the active branch's
+// reads become dependencies, so changes to signals read solely by inactive
+// branches never cause recomputation. Like Memo, it is lazy.
+func Switch[T comparable, R any](s *Scope, key Readonly[T], cases map[T]func() R, defaultFn func() R) Readonly[R] {
+	return Memo(s, func() R {
+		if branch, ok := cases[key.Get()]; ok {
+			return branch()
+		}
+		return defaultFn()
+	})
+}
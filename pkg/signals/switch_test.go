@@ -0,0 +1,94 @@
+package signals
+
+import "testing"
+
+func TestSwitch_EvaluatesMatchingCase(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	mode := New(s, "light")
+	theme := Switch(s, mode, map[string]func() string{
+		"light": func() string { return "#fff" },
+		"dark":  func() string { return "#000" },
+	}, func() string { return "#888" })
+
+	if val := theme.Get(); val != "#fff" {
+		t.Errorf("Expected %q, got %q", "#fff", val)
+	}
+
+	mode.Set("dark")
+	if val := theme.Get(); val != "#000" {
+		t.Errorf("Expected %q, got %q", "#000", val)
+	}
+
+	mode.Set("sepia")
+	if val := theme.Get(); val != "#888" {
+		t.Errorf("Expected default %q, got %q", "#888", val)
+	}
+}
+
+func TestSwitch_InactiveBranchesDoNotSubscribe(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	key := New(s, 1)
+	a := New(s, "a")
+	b := New(s, "b")
+	runCount := 0
+	result := Switch(s, key, map[int]func() string{
+		1: func() string { return a.Get() },
+		2: func() string { return b.Get() },
+	}, func() string { return "" })
+
+	Effect(s, func() {
+		_ = result.Get()
+		runCount++
+	})
+
+	b.Set("b2")
+	if runCount != 1 {
+		t.Errorf("Expected inactive branch change not to re-run, ran %d times", runCount)
+	}
+
+	key.Set(2)
+	if runCount != 2 {
+		t.Fatalf("Expected key change to re-run, ran %d times", runCount)
+	}
+
+	a.Set("a2")
+	if runCount != 2 {
+		t.Errorf("Expected previously active branch to be unsubscribed, ran %d times", runCount)
+	}
+
+	b.Set("b3")
+	if runCount != 3 || result.Get() != "b3" {
+		t.Errorf("Expected active branch change to re-run with %q, ran %d times with %q", "b3", runCount, result.Get())
+	}
+}
+
+func TestSwitch_BranchCanReadMemoThenSignal(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	key := New(s, true)
+	base := New(s, 1)
+	offset := New(s, 10)
+	doubled := Memo(s, func() int { return base.Get() * 2 })
+	result := Switch(s, key, map[bool]func() int{
+		true: func() int { return doubled.Get() + offset.Get() },
+	}, func() int { return 0 })
+
+	if val := result.Get(); val != 12 {
+		t.Fatalf("Expected 12, got %d", val)
+	}
+
+	// offset is read after the nested memo has computed, so it must still be
+	// attributed to the switch.
+	offset.Set(20)
+	if val := result.Get(); val != 22 {
+		t.Errorf("Expected 22 after offset changed, got %d", val)
+	}
+}
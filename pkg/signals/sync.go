@@ -0,0 +1,45 @@
+package signals
+
+import "sync/atomic"
+
+// Sync keeps a and b holding the same value: a write to either is copied to
+// the other. At setup a wins, so b is set to a's current value if it
+// differs. Calling stop, or disposing s, ends the syncing and leaves both
+// signals as they are.
+//
+// Copies don't echo back. When T is comparable, a copy is skipped if the
+// target already holds the value, which also stops the copy Sync just made
+// from being copied back. For other types, the side Sync just wrote is
+// flagged so its own change isn't copied back. Sync observes both sides
+// through effects, so if both are written within one batch, which write wins
+// is unspecified.
+func Sync[T any](s *Scope, a, b Signal[T]) (stop func()) {
+	eq := comparableEquals[T]()
+	// echoA and echoB mark a side of non-comparable type that was just
+	// written by the other's effect.
+	var echoA, echoB atomic.Bool
+	follow := func(src, dst Signal[T], echoSrc, echoDst *atomic.Bool) func() {
+		return func() {
+			v := src.Get()
+			if echoSrc.Swap(false) {
+				return
+			}
+			Untrack(s, func() {
+				if eq != nil {
+					if !eq(dst.Get(), v) {
+						dst.Set(v)
+					}
+					return
+				}
+				echoDst.Store(true)
+				dst.Set(v)
+			})
+		}
+	}
+	stopA := Effect(s, follow(a, b, &echoA, &echoB))
+	stopB := Effect(s, follow(b, a, &echoB, &echoA))
+	return func() {
+		stopA()
+		stopB()
+	}
+}
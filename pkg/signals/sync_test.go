@@ -0,0 +1,53 @@
+package signals
+
+import "testing"
+
+func TestSync_CopiesEachWayOnce(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	a := New(s, 1)
+	b := New(s, 2)
+	Sync(s, a, b)
+	if b.Get() != 1 {
+		t.Fatalf("Expected a to win at setup, b is %d", b.Get())
+	}
+
+	runsA, runsB := 0, 0
+	Effect(s, func() { _ = a.Get(); runsA++ })
+	Effect(s, func() { _ = b.Get(); runsB++ })
+
+	a.Set(5)
+	if b.Get() != 5 || runsA != 2 || runsB != 2 {
+		t.Errorf("Expected b to follow a once, b=%d with %d and %d runs", b.Get(), runsA, runsB)
+	}
+	b.Set(7)
+	if a.Get() != 7 || runsA != 3 || runsB != 3 {
+		t.Errorf("Expected a to follow b once, a=%d with %d and %d runs", a.Get(), runsA, runsB)
+	}
+}
+
+func TestSync_NonComparableDoesNotLoop(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	a := New(s, []int{1})
+	b := New(s, []int(nil))
+	stop := Sync(s, a, b)
+
+	writesA := 0
+	Effect(s, func() { _ = a.Get(); writesA++ })
+
+	b.Set([]int{2})
+	if got := a.Get(); len(got) != 1 || got[0] != 2 || writesA != 2 {
+		t.Errorf("Expected a to be set once to [2], got %v after %d runs", got, writesA)
+	}
+
+	stop()
+	b.Set([]int{3})
+	if got := a.Get(); got[0] != 2 {
+		t.Errorf("Expected no syncing after stop, got %v", got)
+	}
+}
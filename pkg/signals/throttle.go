@@ -0,0 +1,129 @@
+package signals
+
+import (
+	"sync"
+	"time"
+)
+
+// ThrottleOption configures Throttled.
+type ThrottleOption func(*throttleConfig)
+
+type throttleConfig struct {
+	leading, trailing bool
+}
+
+// Leading controls whether a change that opens a throttle window is emitted
+// immediately. It is on by default.
+func Leading(on bool) ThrottleOption {
+	return func(c *throttleConfig) {
+		c.leading = on
+	}
+}
+
+// Trailing controls whether the latest change suppressed during a throttle
+// window is emitted when the window closes, instead of being dropped. It is
+// off by default.
+func Trailing(on bool) ThrottleOption {
+	return func(c *throttleConfig) {
+		c.trailing = on
+	}
+}
+
+// Throttled returns a signal that follows src but changes at most once per
+// interval. A change while no window is open opens one and, with Leading,
+// is emitted at once. Further changes inside the window are suppressed;
+// with Trailing the latest of them is emitted when the window closes, which
+// opens the next window. With neither option on, Leading is assumed. Time is
+// read from the engine's clock.
+func Throttled[T any](s *Scope, src Readonly[T], interval time.Duration, opts ...ThrottleOption) Readonly[T] {
+	cfg := throttleConfig{leading: true}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if !cfg.leading && !cfg.trailing {
+		cfg.leading = true
+	}
+
+	var initial T
+	Untrack(s, func() {
+		initial = src.Get()
+	})
+	t := &throttler[T]{
+		out:      New(s, initial),
+		clock:    s.engine.clock,
+		interval: interval,
+		cfg:      cfg,
+	}
+
+	started := false
+	stop := Effect(s, func() {
+		v := src.Get()
+		if !started {
+			started = true
+			return
+		}
+		t.offer(v)
+	})
+	onScopeCleanup(s, func() {
+		stop()
+		t.stop()
+	})
+	return t.out
+}
+
+type throttler[T any] struct {
+	out      Signal[T]
+	clock    Clock
+	interval time.Duration
+	cfg      throttleConfig
+
+	mu         sync.Mutex
+	timer      Timer // the open window, if any
+	pending    T
+	hasPending bool
+}
+
+func (t *throttler[T]) offer(v T) {
+	t.mu.Lock()
+	if t.timer != nil {
+		t.pending, t.hasPending = v, true
+		t.mu.Unlock()
+		return
+	}
+	t.timer = t.clock.AfterFunc(t.interval, t.closeWindow)
+	if !t.cfg.leading {
+		t.pending, t.hasPending = v, true
+		t.mu.Unlock()
+		return
+	}
+	t.mu.Unlock()
+	t.out.Set(v)
+}
+
+// closeWindow ends the open window, emitting the suppressed change if
+// trailing emission is on.
+func (t *throttler[T]) closeWindow() {
+	t.mu.Lock()
+	t.timer = nil
+	if !t.cfg.trailing || !t.hasPending {
+		t.hasPending = false
+		t.mu.Unlock()
+		return
+	}
+	v := t.pending
+	var zero T
+	t.pending, t.hasPending = zero, false
+	t.timer = t.clock.AfterFunc(t.interval, t.closeWindow)
+	t.mu.Unlock()
+	t.out.Set(v)
+}
+
+func (t *throttler[T]) stop() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.timer != nil {
+		t.timer.Stop()
+		t.timer = nil
+	}
+	t.hasPending = false
+}
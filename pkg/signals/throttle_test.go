@@ -0,0 +1,87 @@
+package signals_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/edgarvarela24/signals-go/pkg/signals"
+	"github.com/edgarvarela24/signals-go/pkg/signals/signalstest"
+)
+
+// emission is a value a throttled signal took on and when.
+type emission struct {
+	at    time.Duration
+	value int
+}
+
+// runThrottled writes 1 at 0ms, 2 at 30ms and 3 at 60ms to a signal
+// throttled to 100ms, then runs the clock to 300ms, recording emissions.
+func runThrottled(t *testing.T, opts ...signals.ThrottleOption) []emission {
+	t.Helper()
+	start := time.Unix(0, 0)
+	clock := signalstest.NewFakeClock(start)
+	eng := signals.Start(signals.WithClock(clock))
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := signals.New(s, 0)
+	throttled := signals.Throttled(s, src, 100*time.Millisecond, opts...)
+	var got []emission
+	started := false
+	signals.Effect(s, func() {
+		v := throttled.Get()
+		if !started {
+			started = true
+			return
+		}
+		got = append(got, emission{at: clock.Now().Sub(start), value: v})
+	})
+
+	for i, v := range []int{1, 2, 3} {
+		if i > 0 {
+			clock.Advance(30 * time.Millisecond)
+		}
+		src.Set(v)
+	}
+	clock.Advance(240 * time.Millisecond)
+	return got
+}
+
+func TestThrottled_LeadingOnly(t *testing.T) {
+	got := runThrottled(t)
+	if want := []emission{{0, 1}}; !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestThrottled_TrailingOnly(t *testing.T) {
+	got := runThrottled(t, signals.Leading(false), signals.Trailing(true))
+	if want := []emission{{100 * time.Millisecond, 3}}; !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestThrottled_LeadingAndTrailing(t *testing.T) {
+	got := runThrottled(t, signals.Trailing(true))
+	want := []emission{{0, 1}, {100 * time.Millisecond, 3}}
+	if !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestThrottled_DisposeStopsWindow(t *testing.T) {
+	clock := signalstest.NewFakeClock(time.Unix(0, 0))
+	eng := signals.Start(signals.WithClock(clock))
+	s := eng.Scope()
+
+	src := signals.New(s, 0)
+	signals.Throttled(s, src, time.Second, signals.Trailing(true))
+	src.Set(1)
+	src.Set(2)
+
+	eng.Close()
+	if n := clock.Pending(); n != 0 {
+		t.Errorf("Expected no pending timers after close, got %d", n)
+	}
+}
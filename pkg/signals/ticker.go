@@ -0,0 +1,83 @@
+package signals
+
+import (
+	"sync"
+	"time"
+)
+
+// Ticker returns a reactive clock: a signal holding clock's current time,
+// refreshed every interval until s is disposed.
+func Ticker(s *Scope, clock Clock, interval time.Duration) Readonly[time.Time] {
+	out := New(s, clock.Now())
+
+	var (
+		mu      sync.Mutex
+		timer   Timer
+		stopped bool
+	)
+	var tick func()
+	tick = func() {
+		out.Set(clock.Now())
+		mu.Lock()
+		defer mu.Unlock()
+		if !stopped {
+			timer = clock.AfterFunc(interval, tick)
+		}
+	}
+	timer = clock.AfterFunc(interval, tick)
+
+	onScopeCleanup(s, func() {
+		mu.Lock()
+		defer mu.Unlock()
+		stopped = true
+		timer.Stop()
+	})
+	return out
+}
+
+// TickOption configures combinators that refresh on a Ticker, such as
+// TimeSinceChange and Integrate.
+type TickOption func(*tickConfig)
+
+type tickConfig struct {
+	tick time.Duration
+}
+
+func newTickConfig(opts []TickOption) tickConfig {
+	cfg := tickConfig{tick: time.Second}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// TickEvery sets how often the combinator refreshes. The default is one
+// second.
+func TickEvery(d time.Duration) TickOption {
+	return func(c *tickConfig) {
+		c.tick = d
+	}
+}
+
+// TimeSinceChange returns a signal holding how long ago src last changed,
+// according to clock, counting from creation until the first change. It is
+// refreshed on every tick of a Ticker and reset to zero whenever src
+// changes.
+func TimeSinceChange[T any](s *Scope, src Readonly[T], clock Clock, opts ...TickOption) Readonly[time.Duration] {
+	cfg := newTickConfig(opts)
+	changed := New(s, clock.Now())
+	started := false
+	Effect(s, func() {
+		_ = src.Get()
+		if !started {
+			started = true
+			return
+		}
+		changed.Set(clock.Now())
+	})
+
+	now := Ticker(s, clock, cfg.tick)
+	return Memo(s, func() time.Duration {
+		return max(now.Get().Sub(changed.Get()), 0)
+	})
+}
@@ -0,0 +1,60 @@
+package signals_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgarvarela24/signals-go/pkg/signals"
+	"github.com/edgarvarela24/signals-go/pkg/signals/signalstest"
+)
+
+func TestTicker_RefreshesEveryInterval(t *testing.T) {
+	start := time.Unix(0, 0)
+	clock := signalstest.NewFakeClock(start)
+	eng := signals.Start(signals.WithClock(clock))
+	s := eng.Scope()
+
+	now := signals.Ticker(s, clock, time.Second)
+	clock.Advance(2500 * time.Millisecond)
+	if got := now.Get().Sub(start); got != 2*time.Second {
+		t.Errorf("Expected the time of the last tick, 2s, got %v", got)
+	}
+
+	eng.Close()
+	if n := clock.Pending(); n != 0 {
+		t.Errorf("Expected the ticker to stop on close, %d timers pending", n)
+	}
+}
+
+func TestTimeSinceChange_GrowsAndResets(t *testing.T) {
+	clock := signalstest.NewFakeClock(time.Unix(0, 0))
+	eng := signals.Start(signals.WithClock(clock))
+	defer eng.Close()
+	s := eng.Scope()
+
+	status := signals.New(s, "ok")
+	since := signals.TimeSinceChange(s, status, clock, signals.TickEvery(time.Second))
+	var seen []time.Duration
+	signals.Effect(s, func() {
+		seen = append(seen, since.Get())
+	})
+
+	clock.Advance(3 * time.Second)
+	if got := since.Get(); got != 3*time.Second {
+		t.Errorf("Expected 3s since creation, got %v", got)
+	}
+
+	clock.Advance(500 * time.Millisecond)
+	status.Set("degraded")
+	if got := since.Get(); got != 0 {
+		t.Errorf("Expected the duration to reset on change, got %v", got)
+	}
+
+	clock.Advance(2500 * time.Millisecond)
+	if got := since.Get(); got != 2500*time.Millisecond {
+		t.Errorf("Expected 2.5s since the change, got %v", got)
+	}
+	if last := seen[len(seen)-1]; last != 2500*time.Millisecond {
+		t.Errorf("Expected subscribers to observe 2.5s, got %v", last)
+	}
+}
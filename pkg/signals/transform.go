@@ -0,0 +1,23 @@
+package signals
+
+// Map returns a computed value holding fn applied to src. Like Memo it is
+// lazy and cold: it only subscribes to src once read, and detaches again
+// after its last subscriber leaves.
+func Map[A, B any](s *Scope, src Readonly[A], fn func(A) B) Readonly[B] {
+	return Memo(s, func() B {
+		return fn(src.Get())
+	})
+}
+
+// Filter returns a computed value holding the latest value of src that
+// satisfied pred, or T's zero value if none has yet. It is lazy and cold
+// like Map, so only values present when it is read are considered.
+func Filter[T any](s *Scope, src Readonly[T], pred func(T) bool) Readonly[T] {
+	var last T
+	return Memo(s, func() T {
+		if v := src.Get(); pred(v) {
+			last = v
+		}
+		return last
+	})
+}
@@ -0,0 +1,81 @@
+package signals
+
+import "testing"
+
+func TestMap_AppliesFunction(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := New(s, 2)
+	squared := Map(s, src, func(v int) int { return v * v })
+
+	if val := squared.Get(); val != 4 {
+		t.Errorf("Expected 4, got %d", val)
+	}
+	src.Set(3)
+	if val := squared.Get(); val != 9 {
+		t.Errorf("Expected 9, got %d", val)
+	}
+}
+
+func TestFilter_KeepsLastPassingValue(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := New(s, 1)
+	even := Filter(s, src, func(v int) bool { return v%2 == 0 })
+
+	if val := even.Get(); val != 0 {
+		t.Errorf("Expected zero value before any value passes, got %d", val)
+	}
+	src.Set(4)
+	if val := even.Get(); val != 4 {
+		t.Errorf("Expected 4, got %d", val)
+	}
+	src.Set(5)
+	if val := even.Get(); val != 4 {
+		t.Errorf("Expected 4 to be kept when 5 is filtered out, got %d", val)
+	}
+}
+
+func TestMap_IsColdUntilReadAndDetachesWhenUnused(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := New(s, 1)
+	runCount := 0
+	doubled := Map(s, src, func(v int) int {
+		runCount++
+		return v * 2
+	})
+
+	if n := len(Subscribers(src)); n != 0 {
+		t.Fatalf("Expected a cold Map not to subscribe to its source, got %d subscribers", n)
+	}
+
+	stop := Effect(s, func() {
+		_ = doubled.Get()
+	})
+	if n := len(Subscribers(src)); n != 1 {
+		t.Fatalf("Expected Map to subscribe once read, got %d subscribers", n)
+	}
+
+	stop()
+	src.Set(2)
+	if n := len(Subscribers(src)); n != 0 {
+		t.Errorf("Expected Map to detach after its last subscriber left, got %d subscribers", n)
+	}
+
+	src.Set(3)
+	src.Set(4)
+	if runCount != 1 {
+		t.Errorf("Expected no recomputation while cold, ran %d times", runCount)
+	}
+
+	if val := doubled.Get(); val != 8 || runCount != 2 {
+		t.Errorf("Expected a fresh read to recompute 8, got %d after %d runs", val, runCount)
+	}
+}
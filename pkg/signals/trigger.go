@@ -0,0 +1,28 @@
+package signals
+
+// Trigger is a signal without a value, modelling pure events such as "button
+// clicked". Computations depend on it by calling Track, and re-run whenever
+// it fires. Fires inside a batch coalesce into a single re-run.
+type Trigger struct {
+	scope *Scope
+	subs  subscriberSet
+}
+
+// NewTrigger creates a trigger.
+func NewTrigger(s *Scope) *Trigger {
+	return &Trigger{scope: s}
+}
+
+// Track subscribes the active computation, if any, to the trigger.
+func (t *Trigger) Track() {
+	t.subs.track(t, t.scope.engine)
+}
+
+// Fire notifies every computation tracking the trigger.
+func (t *Trigger) Fire() {
+	t.subs.notify(t.scope.engine)
+}
+
+func (t *Trigger) unsubscribe(c computation) {
+	t.subs.remove(c)
+}
@@ -0,0 +1,63 @@
+package signals
+
+import "testing"
+
+func TestTrigger_EffectRerunsOnEachFire(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	clicked := NewTrigger(s)
+	runCount := 0
+	Effect(s, func() {
+		clicked.Track()
+		runCount++
+	})
+
+	clicked.Fire()
+	clicked.Fire()
+	if runCount != 3 {
+		t.Errorf("Expected effect to re-run on each fire, ran %d times", runCount)
+	}
+}
+
+func TestTrigger_FiresCoalesceInBatch(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	clicked := NewTrigger(s)
+	runCount := 0
+	Effect(s, func() {
+		clicked.Track()
+		runCount++
+	})
+
+	s.Batch(func() {
+		clicked.Fire()
+		clicked.Fire()
+		clicked.Fire()
+	})
+	if runCount != 2 {
+		t.Errorf("Expected one re-run per batch, ran %d times", runCount)
+	}
+}
+
+func TestTrigger_UntrackedEffectDoesNotRerun(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	clicked := NewTrigger(s)
+	runCount := 0
+	stop := Effect(s, func() {
+		clicked.Track()
+		runCount++
+	})
+
+	stop()
+	clicked.Fire()
+	if runCount != 1 {
+		t.Errorf("Expected stopped effect not to re-run, ran %d times", runCount)
+	}
+}
@@ -0,0 +1,35 @@
+package signals
+
+// MaxSeen returns a signal holding the largest value src has ever had,
+// according to less. Unlike a memo it retains history: it starts at src's
+// value at creation time and only ever moves up from there.
+func MaxSeen[T any](s *Scope, src Readonly[T], less func(a, b T) bool) Readonly[T] {
+	return watermark(s, src, less)
+}
+
+// MinSeen returns a signal holding the smallest value src has ever had,
+// according to less. It starts at src's value at creation time and only ever
+// moves down from there.
+func MinSeen[T any](s *Scope, src Readonly[T], less func(a, b T) bool) Readonly[T] {
+	return watermark(s, src, func(a, b T) bool {
+		return less(b, a)
+	})
+}
+
+// watermark tracks the value of src that ranks highest under before.
+func watermark[T any](s *Scope, src Readonly[T], before func(a, b T) bool) Readonly[T] {
+	var best T
+	Untrack(s, func() {
+		best = src.Get()
+	})
+	out := New(s, best)
+
+	Effect(s, func() {
+		v := src.Get()
+		if before(best, v) {
+			best = v
+			out.Set(v)
+		}
+	})
+	return out
+}
@@ -0,0 +1,71 @@
+package signals
+
+import "testing"
+
+func TestMaxSeen_OnlyGoesUp(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := New(s, 5)
+	high := MaxSeen(s, src, func(a, b int) bool { return a < b })
+
+	if val := high.Get(); val != 5 {
+		t.Fatalf("Expected initial max to be the source's value 5, got %d", val)
+	}
+
+	for _, v := range []int{4, 3, 1} {
+		src.Set(v)
+		if val := high.Get(); val != 5 {
+			t.Errorf("Expected max to stay 5 after setting %d, got %d", v, val)
+		}
+	}
+
+	for _, v := range []int{6, 2, 9, 7} {
+		src.Set(v)
+	}
+	if val := high.Get(); val != 9 {
+		t.Errorf("Expected max to be 9, got %d", val)
+	}
+}
+
+func TestMaxSeen_NotifiesOnlyOnNewHigh(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := New(s, 1)
+	high := MaxSeen(s, src, func(a, b int) bool { return a < b })
+
+	runCount := 0
+	Effect(s, func() {
+		_ = high.Get()
+		runCount++
+	})
+
+	src.Set(0)
+	if runCount != 1 {
+		t.Errorf("Expected effect not to re-run for a lower value, ran %d times", runCount)
+	}
+
+	src.Set(3)
+	if runCount != 2 {
+		t.Errorf("Expected effect to re-run for a new high, ran %d times", runCount)
+	}
+}
+
+func TestMinSeen_OnlyGoesDown(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := New(s, 5)
+	low := MinSeen(s, src, func(a, b int) bool { return a < b })
+
+	for _, v := range []int{7, 3, 8, 4} {
+		src.Set(v)
+	}
+	if val := low.Get(); val != 3 {
+		t.Errorf("Expected min to be 3, got %d", val)
+	}
+}
@@ -0,0 +1,33 @@
+package signals
+
+// Window returns a signal holding the last n values src has taken, oldest
+// first, starting with src's value at creation. Each change appends the new
+// value and drops the oldest once the window is full, so it never holds more
+// than n values; n below 1 is treated as 1. Every change produces a fresh
+// slice.
+//
+// Window observes src through an effect, so writes coalesced by a batch are
+// seen once, with only the value src holds when the batch flushes.
+func Window[T any](s *Scope, src Readonly[T], n int) Readonly[[]T] {
+	n = max(n, 1)
+	var out Signal[[]T]
+	var window []T
+
+	Effect(s, func() {
+		v := src.Get()
+		next := make([]T, 0, n)
+		if len(window) >= n {
+			next = append(next, window[len(window)-n+1:]...)
+		} else {
+			next = append(next, window...)
+		}
+		window = append(next, v)
+
+		if out == nil {
+			out = New(s, window)
+			return
+		}
+		out.Set(window)
+	})
+	return out
+}
@@ -0,0 +1,69 @@
+package signals
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestWindow_SlidesAndNeverExceedsN(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := New(s, 1)
+	win := Window(s, src, 3)
+
+	if got := win.Get(); !slices.Equal(got, []int{1}) {
+		t.Fatalf("Expected initial window [1], got %v", got)
+	}
+
+	want := [][]int{
+		{1, 2},
+		{1, 2, 3},
+		{2, 3, 4},
+		{3, 4, 5},
+	}
+	for i, w := range want {
+		src.Set(i + 2)
+		got := win.Get()
+		if !slices.Equal(got, w) {
+			t.Errorf("after setting %d: expected %v, got %v", i+2, w, got)
+		}
+		if len(got) > 3 {
+			t.Errorf("Expected window never to exceed 3 values, got %d", len(got))
+		}
+	}
+}
+
+func TestWindow_DoesNotMutatePreviousSlices(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := New(s, 1)
+	win := Window(s, src, 2)
+	src.Set(2)
+	before := win.Get()
+	src.Set(3)
+
+	if !slices.Equal(before, []int{1, 2}) {
+		t.Errorf("Expected earlier window to be unchanged, got %v", before)
+	}
+}
+
+func TestWindow_BatchRecordsOnlyFinalValue(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	src := New(s, 1)
+	win := Window(s, src, 5)
+	s.Batch(func() {
+		src.Set(2)
+		src.Set(3)
+	})
+
+	if got := win.Get(); !slices.Equal(got, []int{1, 3}) {
+		t.Errorf("Expected batched writes to coalesce to [1 3], got %v", got)
+	}
+}
@@ -0,0 +1,69 @@
+package signals
+
+import "sync"
+
+// ZipChanged returns a signal pairing the latest values of a and b, which
+// only moves on once both a and b have changed since the pair it holds.
+// It starts out holding the values at creation, which count as the first
+// pair. A side that changes several times before the other catches up
+// contributes its latest value.
+func ZipChanged[A, B any](s *Scope, a Readonly[A], b Readonly[B]) Readonly[struct {
+	A A
+	B B
+}] {
+	type pair = struct {
+		A A
+		B B
+	}
+	var (
+		mu           sync.Mutex
+		latest       pair
+		aSeen, bSeen bool
+	)
+	Untrack(s, func() {
+		latest = pair{A: a.Get(), B: b.Get()}
+	})
+	out := New(s, latest)
+
+	// record applies a change, publishing the pair once both sides have
+	// changed.
+	record := func(change func()) {
+		mu.Lock()
+		change()
+		ready := aSeen && bSeen
+		if ready {
+			aSeen, bSeen = false, false
+		}
+		p := latest
+		mu.Unlock()
+		if ready {
+			out.Set(p)
+		}
+	}
+
+	aStarted := false
+	stopA := Effect(s, func() {
+		v := a.Get()
+		if !aStarted {
+			aStarted = true
+			return
+		}
+		record(func() { latest.A, aSeen = v, true })
+	})
+
+	bStarted := false
+	stopB := Effect(s, func() {
+		v := b.Get()
+		if !bStarted {
+			bStarted = true
+			return
+		}
+		record(func() { latest.B, bSeen = v, true })
+	})
+
+	onScopeCleanup(s, func() {
+		stopA()
+		stopB()
+	})
+	return out
+}
@@ -0,0 +1,38 @@
+package signals
+
+import "testing"
+
+func TestZipChanged_EmitsOnlyAfterBothSidesChange(t *testing.T) {
+	eng := Start()
+	defer eng.Close()
+	s := eng.Scope()
+
+	a := New(s, 0)
+	b := New(s, "x")
+	zipped := ZipChanged(s, a, b)
+
+	emits := 0
+	Effect(s, func() {
+		_ = zipped.Get()
+		emits++
+	})
+	if got := zipped.Get(); got.A != 0 || got.B != "x" {
+		t.Errorf("Expected the initial pair {0 x}, got %+v", got)
+	}
+
+	a.Set(1)
+	a.Set(2)
+	if emits != 1 {
+		t.Errorf("Expected no emission while only a changed, got %d", emits-1)
+	}
+
+	b.Set("y")
+	if got := zipped.Get(); got.A != 2 || got.B != "y" {
+		t.Errorf("Expected {2 y}, got %+v", got)
+	}
+
+	b.Set("z")
+	if emits != 2 {
+		t.Errorf("Expected the seen flags to reset after emitting, got %d emissions", emits-1)
+	}
+}